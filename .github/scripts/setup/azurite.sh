@@ -0,0 +1,22 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+: "${ENV_FILE:?ENV_FILE is not set}"
+
+export AZURITE_BLOB_ENDPOINT="http://127.0.0.1:10000/devstoreaccount1"
+
+docker run -d --name azurite -p 10000:10000 \
+    mcr.microsoft.com/azure-storage/azurite \
+    azurite-blob --blobHost 0.0.0.0 --blobPort 10000 --loose
+
+for _ in $(seq 1 30); do
+    if curl -s -o /dev/null "$AZURITE_BLOB_ENDPOINT"; then
+        break
+    fi
+    sleep 1
+done
+
+touch "$ENV_FILE"
+
+printf "export AZURITE_BLOB_ENDPOINT='%s'\n" "$AZURITE_BLOB_ENDPOINT" >> "$ENV_FILE"
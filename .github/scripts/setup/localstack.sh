@@ -0,0 +1,22 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+: "${ENV_FILE:?ENV_FILE is not set}"
+
+export LOCALSTACK_ENDPOINT="http://127.0.0.1:4566"
+
+docker run -d --name localstack -p 4566:4566 \
+    -e SERVICES=s3,dynamodb \
+    localstack/localstack
+
+for _ in $(seq 1 60); do
+    if curl -s "$LOCALSTACK_ENDPOINT/_localstack/health" | grep -Eq '"s3": ?"(available|running)"'; then
+        break
+    fi
+    sleep 1
+done
+
+touch "$ENV_FILE"
+
+printf "export LOCALSTACK_ENDPOINT='%s'\n" "$LOCALSTACK_ENDPOINT" >> "$ENV_FILE"
@@ -0,0 +1,27 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+: "${ENV_FILE:?ENV_FILE is not set}"
+
+export BAO_ADDR="http://127.0.0.1:8200"
+export BAO_TOKEN="root"
+
+docker run -d --name openbao -p 8200:8200 \
+    -e BAO_DEV_ROOT_TOKEN_ID="$BAO_TOKEN" \
+    openbao/openbao server -dev -dev-listen-address=0.0.0.0:8200
+
+for _ in $(seq 1 30); do
+    if curl -sf "$BAO_ADDR/v1/sys/health" > /dev/null; then
+        break
+    fi
+    sleep 1
+done
+
+docker exec -e BAO_ADDR="$BAO_ADDR" -e BAO_TOKEN="$BAO_TOKEN" openbao bao secrets enable transit
+docker exec -e BAO_ADDR="$BAO_ADDR" -e BAO_TOKEN="$BAO_TOKEN" openbao bao write -f transit/keys/terragrunt-test
+
+touch "$ENV_FILE"
+
+printf "export BAO_ADDR='%s'\n" "$BAO_ADDR" >> "$ENV_FILE"
+printf "export BAO_TOKEN='%s'\n" "$BAO_TOKEN" >> "$ENV_FILE"
@@ -4,7 +4,13 @@ package backend
 import (
 	"github.com/gruntwork-io/terragrunt/cli/commands/backend/bootstrap"
 	"github.com/gruntwork-io/terragrunt/cli/commands/backend/delete"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/encryption"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/lock"
 	"github.com/gruntwork-io/terragrunt/cli/commands/backend/migrate"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/mv"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/orphans"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/state"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/verify"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -19,7 +25,13 @@ func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
 		Subcommands: cli.Commands{
 			bootstrap.NewCommand(l, opts),
 			delete.NewCommand(l, opts),
+			encryption.NewCommand(l, opts),
+			lock.NewCommand(l, opts),
 			migrate.NewCommand(l, opts),
+			mv.NewCommand(l, opts),
+			orphans.NewCommand(l, opts),
+			state.NewCommand(l, opts),
+			verify.NewCommand(l, opts),
 		},
 		Action: cli.ShowCommandHelp,
 	}
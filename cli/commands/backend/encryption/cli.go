@@ -0,0 +1,69 @@
+package encryption
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "encryption"
+
+	RotateCommandName = "rotate"
+
+	ProgressFileFlagName = "progress-file"
+	RestartFlagName      = "restart"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Manage the encryption of the OpenTofu state stored in the backends of all discovered units.",
+		Subcommands: cli.Commands{
+			NewRotateCommand(l, opts),
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
+
+func NewRotateFlags(l log.Logger, opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	flags := cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        ProgressFileFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProgressFileFlagName),
+			Destination: &opts.ProgressFile,
+			Usage:       "The file where the progress of the rotation is saved, so an interrupted rotation can resume.",
+			DefaultText: DefaultProgressFile,
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        RestartFlagName,
+			EnvVars:     tgPrefix.EnvVars(RestartFlagName),
+			Destination: &opts.Restart,
+			Usage:       "Discard the progress of a previous rotation and rotate the key of all units again.",
+		}),
+	}
+
+	return append(flags, run.NewFlags(l, opts.TerragruntOptions, nil).Filter(
+		run.DownloadDirFlagName,
+		run.StateSnapshotDirFlagName,
+		run.StateSnapshotBucketPrefixFlagName,
+		run.StateSnapshotRetentionFlagName,
+	)...)
+}
+
+func NewRotateCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:  RotateCommandName,
+		Usage: "Re-encrypt the state of all discovered units from the fallback key provider of their `remote_state` encryption config to the primary one.",
+		Flags: NewRotateFlags(l, cmdOpts, nil),
+		Action: func(ctx *cli.Context) error {
+			return Rotate(ctx, l, cmdOpts)
+		},
+	}
+}
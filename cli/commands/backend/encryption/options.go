@@ -0,0 +1,25 @@
+package encryption
+
+import (
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// DefaultProgressFile is the file, relative to the working directory, where the progress of a key rotation is saved.
+const DefaultProgressFile = ".terragrunt-encryption-rotate.json"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// ProgressFile is the file where the progress of the key rotation is saved, so an interrupted rotation can resume.
+	ProgressFile string
+
+	// Restart determines if the progress of a previous rotation should be discarded.
+	Restart bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		ProgressFile:      DefaultProgressFile,
+	}
+}
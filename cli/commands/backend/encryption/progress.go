@@ -0,0 +1,77 @@
+package encryption
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// The progress file only lists unit paths, but it is kept private like the state files.
+const ownerReadWritePerms = 0600
+
+// Progress is the progress of a key rotation. It is saved after each rotated unit, so an interrupted rotation
+// resumes with the units that were not rotated yet.
+type Progress struct {
+	path string
+
+	// Rotated are the paths, relative to the working directory, of the units whose state was rotated.
+	Rotated []string `json:"rotated"`
+}
+
+// LoadProgress loads the progress of a key rotation from the given file. Returns an empty progress if the file
+// does not exist.
+func LoadProgress(path string) (*Progress, error) {
+	progress := &Progress{path: path}
+
+	if !util.FileExists(path) {
+		return progress, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, errors.Errorf("failed to parse key rotation progress file %s: %w", path, err)
+	}
+
+	return progress, nil
+}
+
+// IsRotated returns true if the state of the given unit was already rotated.
+func (progress *Progress) IsRotated(unitPath string) bool {
+	return slices.Contains(progress.Rotated, unitPath)
+}
+
+// MarkRotated records the state of the given unit as rotated and saves the progress.
+func (progress *Progress) MarkRotated(unitPath string) error {
+	if !progress.IsRotated(unitPath) {
+		progress.Rotated = append(progress.Rotated, unitPath)
+	}
+
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.WriteFile(progress.path, append(data, '\n'), ownerReadWritePerms); err != nil {
+		return errors.Errorf("failed to save key rotation progress to %s: %w", progress.path, err)
+	}
+
+	return nil
+}
+
+// Reset discards the progress and removes the progress file.
+func (progress *Progress) Reset() error {
+	progress.Rotated = nil
+
+	if err := os.Remove(progress.path); err != nil && !os.IsNotExist(err) {
+		return errors.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package encryption provides the ability to rotate the keys the OpenTofu state of units is encrypted with.
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/state"
+	"github.com/gruntwork-io/terragrunt/codegen"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// SnapshotCommandName is the command name recorded in the state snapshots taken before rotating the key of a unit.
+const SnapshotCommandName = "encryption-rotate"
+
+// Rotate re-encrypts the state of the discovered units whose `remote_state` encryption config has a `fallback` key
+// provider. The state is pulled with OpenTofu's fallback mechanism, which decrypts it with the fallback key provider,
+// and pushed back, which encrypts it with the primary key provider. The result is then verified by pulling the state
+// with the primary key provider only. The progress is saved after each unit, so an interrupted rotation resumes
+// with the units that were not rotated yet.
+func Rotate(ctx context.Context, l log.Logger, opts *Options) error {
+	progressFile, err := util.CanonicalPath(opts.ProgressFile, opts.WorkingDir)
+	if err != nil {
+		return err
+	}
+
+	progress, err := LoadProgress(progressFile)
+	if err != nil {
+		return err
+	}
+
+	if opts.Restart {
+		if err := progress.Reset(); err != nil {
+			return err
+		}
+	} else if len(progress.Rotated) > 0 {
+		l.Infof("Resuming key rotation, %d unit(s) already rotated", len(progress.Rotated))
+	}
+
+	cfgs, err := discovery.NewDiscovery(opts.WorkingDir).Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	var rotated int
+
+	for _, cfg := range cfgs.Filter(discovery.ConfigTypeUnit).Sort() {
+		relPath, err := filepath.Rel(opts.WorkingDir, cfg.Path)
+		if err != nil {
+			return errors.New(err)
+		}
+
+		relPath = filepath.ToSlash(relPath)
+
+		if progress.IsRotated(relPath) {
+			l.Debugf("Key of unit %s already rotated, skipping", relPath)
+
+			continue
+		}
+
+		l, unitOpts, err := opts.CloneWithConfigPath(l, filepath.Join(cfg.Path, config.DefaultTerragruntConfigPath))
+		if err != nil {
+			return err
+		}
+
+		unitOpts.OriginalTerragruntConfigPath = unitOpts.TerragruntConfigPath
+
+		// Check the remote state first, so units that have nothing to rotate are not initialized.
+		remoteState, err := config.ParseRemoteState(ctx, l, unitOpts)
+		if err != nil {
+			return err
+		}
+
+		if remoteState == nil || !hasEncryptionFallback(remoteState) {
+			l.Debugf("No %s key provider in the encryption config, skipping unit %s", codegen.EncryptionFallbackKey, relPath)
+
+			continue
+		}
+
+		var unitRotated bool
+
+		err = state.RunWithRemoteState(ctx, l, unitOpts, tf.CommandNamePush, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error {
+			var err error
+
+			unitRotated, err = rotateUnit(ctx, l, opts, remoteState)
+
+			return err
+		})
+		if err != nil {
+			return errors.Errorf("failed to rotate the key of unit %s, run the command again to resume the rotation: %w", relPath, err)
+		}
+
+		if !unitRotated {
+			continue
+		}
+
+		if err := progress.MarkRotated(relPath); err != nil {
+			return err
+		}
+
+		rotated++
+	}
+
+	l.Infof("Rotated the key of %d unit(s)", rotated)
+
+	// The rotation is complete, so the next one starts from scratch.
+	return progress.Reset()
+}
+
+// rotateUnit re-encrypts the state of the unit initialized in `opts.WorkingDir` with the primary key provider,
+// and returns false if the user declined.
+func rotateUnit(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) (bool, error) {
+	if remoteState.Generate == nil {
+		return false, errors.New("the `generate` attribute of `remote_state` is required to configure the state encryption")
+	}
+
+	prompt := fmt.Sprintf("The %s backend state of unit %s will be re-encrypted with the %s key provider. Do you want to continue?", remoteState.BackendName, opts.OriginalTerragruntConfigPath, remoteState.Encryption[codegen.EncryptionKeyProviderKey])
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil || !yes {
+		return false, err
+	}
+
+	if snapshot.Enabled(opts) {
+		if err := snapshot.Take(ctx, l, opts, remoteState, SnapshotCommandName); err != nil {
+			return false, err
+		}
+	}
+
+	stateFile, err := remoteState.PullState(ctx, l, opts)
+	if stateFile != "" {
+		defer os.Remove(stateFile) //nolint:errcheck
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		return false, errors.New(err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		l.Infof("No state to re-encrypt for unit %s", opts.OriginalTerragruntConfigPath)
+
+		return true, nil
+	}
+
+	// The serial is incremented, since OpenTofu does not persist a pushed state equal to the current one.
+	data, serial, err := remotestate.IncrementStateSerial(data)
+	if err != nil {
+		return false, err
+	}
+
+	if err := os.WriteFile(stateFile, data, ownerReadWritePerms); err != nil {
+		return false, errors.New(err)
+	}
+
+	if err := remoteState.PushState(ctx, l, opts, stateFile, false); err != nil {
+		return false, err
+	}
+
+	if err := verifyUnit(ctx, l, opts, remoteState, serial); err != nil {
+		return false, err
+	}
+
+	l.Infof("Re-encrypted the state of unit %s with the %s key provider", opts.OriginalTerragruntConfigPath, remoteState.Encryption[codegen.EncryptionKeyProviderKey])
+
+	return true, nil
+}
+
+// verifyUnit pulls the state of the unit with the primary key provider only, and checks that it is the pushed one.
+// The backend code with the fallback key provider is generated again afterwards.
+func verifyUnit(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState, serial int64) (err error) {
+	cfg := *remoteState.Config
+	cfg.Encryption = remotestate.WithoutEncryptionFallback(cfg.Encryption)
+
+	verifyRemoteState := remotestate.New(&cfg)
+
+	if err := generateBackendCode(l, opts, verifyRemoteState); err != nil {
+		return err
+	}
+
+	defer func() {
+		if genErr := generateBackendCode(l, opts, remoteState); genErr != nil {
+			err = errors.Join(err, genErr)
+		}
+	}()
+
+	stateFile, err := verifyRemoteState.PullState(ctx, l, opts)
+	if stateFile != "" {
+		defer os.Remove(stateFile) //nolint:errcheck
+	}
+
+	if err != nil {
+		return errors.Errorf("failed to decrypt the state with the %s key provider: %w", cfg.Encryption[codegen.EncryptionKeyProviderKey], err)
+	}
+
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	actualSerial, err := remotestate.ParseStateSerial(data)
+	if err != nil {
+		return err
+	}
+
+	if actualSerial != serial {
+		return errors.Errorf("the state decrypted with the %s key provider has serial %d, expected %d", cfg.Encryption[codegen.EncryptionKeyProviderKey], actualSerial, serial)
+	}
+
+	return nil
+}
+
+// generateBackendCode generates the backend code of the given remote state, overwriting the file generated earlier
+// even if the config only generates it when it does not exist.
+func generateBackendCode(l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error {
+	if remoteState.Generate.IfExists == codegen.ExistsSkipStr {
+		cfg := *remoteState.Config
+		cfg.Generate = &remotestate.ConfigGenerate{Path: cfg.Generate.Path, IfExists: codegen.ExistsOverwriteTerragruntStr}
+		remoteState = remotestate.New(&cfg)
+	}
+
+	return remoteState.GenerateOpenTofuCode(l, opts)
+}
+
+func hasEncryptionFallback(remoteState *remotestate.RemoteState) bool {
+	_, ok := remoteState.Encryption[codegen.EncryptionFallbackKey]
+
+	return ok
+}
@@ -0,0 +1,87 @@
+package encryption_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/encryption"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	progressFile := filepath.Join(t.TempDir(), encryption.DefaultProgressFile)
+
+	progress, err := encryption.LoadProgress(progressFile)
+	require.NoError(t, err)
+	assert.False(t, progress.IsRotated("app"))
+
+	require.NoError(t, progress.MarkRotated("app"))
+	require.NoError(t, progress.MarkRotated("db"))
+	require.NoError(t, progress.MarkRotated("app"))
+
+	progress, err = encryption.LoadProgress(progressFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app", "db"}, progress.Rotated)
+	assert.True(t, progress.IsRotated("db"))
+
+	require.NoError(t, progress.Reset())
+	assert.NoFileExists(t, progressFile)
+	assert.False(t, progress.IsRotated("app"))
+
+	require.NoError(t, os.WriteFile(progressFile, []byte("not-valid-json"), 0600))
+
+	_, err = encryption.LoadProgress(progressFile)
+	require.Error(t, err)
+}
+
+func TestRotateWithoutUnitsToRotate(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		"no-remote-state/terragrunt.hcl": "",
+		"no-fallback/terragrunt.hcl": `
+remote_state {
+  backend = "local"
+  generate = {
+    path      = "backend.tf"
+    if_exists = "overwrite_terragrunt"
+  }
+  config = {
+    path = "terraform.tfstate"
+  }
+  encryption = {
+    key_provider = "pbkdf2"
+    passphrase   = "correct-horse-battery-staple"
+  }
+}
+`,
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, path), []byte(content), 0644))
+	}
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = tmpDir
+	tgOpts.NonInteractive = true
+
+	opts := encryption.NewOptions(tgOpts)
+
+	// The progress of a previous rotation is discarded once the rotation completes.
+	progressFile := filepath.Join(tmpDir, encryption.DefaultProgressFile)
+	require.NoError(t, os.WriteFile(progressFile, []byte(`{"rotated": ["app"]}`), 0600))
+
+	require.NoError(t, encryption.Rotate(t.Context(), logger.CreateLogger(), opts))
+	assert.NoFileExists(t, progressFile)
+}
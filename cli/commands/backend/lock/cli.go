@@ -0,0 +1,105 @@
+package lock
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "lock"
+
+	ListCommandName    = "list"
+	ReleaseCommandName = "release"
+
+	FormatFlagName = "format"
+
+	JSONFlagName  = "json"
+	JSONFlagAlias = "j"
+
+	LockIDFlagName = "lock-id"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "List and release the state locks held on the backends of all discovered units.",
+		Subcommands: cli.Commands{
+			NewListCommand(l, opts),
+			NewReleaseCommand(l, opts),
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
+
+func NewListFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format for the state locks. Valid values: text, json.",
+			DefaultText: FormatText,
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Aliases:     []string{JSONFlagAlias},
+			Destination: &opts.JSON,
+			Usage:       "Output in JSON format (equivalent to --format=json).",
+		}),
+	}
+}
+
+func NewListCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:  ListCommandName,
+		Usage: "List the state locks held on the backends of all discovered units.",
+		Flags: NewListFlags(cmdOpts, nil),
+		Before: func(ctx *cli.Context) error {
+			if cmdOpts.JSON {
+				cmdOpts.Format = FormatJSON
+			}
+
+			if err := cmdOpts.Validate(); err != nil {
+				return cli.NewExitError(err, cli.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx *cli.Context) error {
+			return List(ctx, l, cmdOpts)
+		},
+	}
+}
+
+func NewReleaseFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        LockIDFlagName,
+			EnvVars:     tgPrefix.EnvVars(LockIDFlagName),
+			Destination: &opts.LockID,
+			Usage:       "Release only the lock with the given ID.",
+		}),
+	}
+}
+
+func NewReleaseCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:  ReleaseCommandName,
+		Usage: "Force-release the state locks held on the backends of all discovered units.",
+		Flags: NewReleaseFlags(cmdOpts, nil),
+		Action: func(ctx *cli.Context) error {
+			return Release(ctx, l, cmdOpts)
+		},
+	}
+}
@@ -0,0 +1,240 @@
+// Package lock provides the ability to list and force-release the state locks held on the backends of units.
+package lock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	tabMinWidth = 0
+	tabWidth    = 8
+	tabPadding  = 2
+)
+
+// UnitLock is the state lock held on the backend of a single unit.
+type UnitLock struct {
+	Path    string            `json:"path"`
+	Backend string            `json:"backend"`
+	Age     string            `json:"age,omitempty"`
+	Error   string            `json:"error,omitempty"`
+	Lock    *backend.LockInfo `json:"lock,omitempty"`
+
+	remoteState *remotestate.RemoteState
+	opts        *options.TerragruntOptions
+}
+
+type UnitLocks []*UnitLock
+
+// List lists the state locks held on the backends of the discovered units.
+func List(ctx context.Context, l log.Logger, opts *Options) error {
+	locks, err := findLocks(ctx, l, opts)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case FormatJSON:
+		err = outputJSON(opts, locks)
+	default:
+		err = outputText(opts, locks)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return locks.Err()
+}
+
+// Release force-releases the state locks held on the backends of the discovered units, asking for confirmation
+// for each of them. If `opts.LockID` is set, only the lock with that ID is released.
+func Release(ctx context.Context, l log.Logger, opts *Options) error {
+	locks, err := findLocks(ctx, l, opts)
+	if err != nil {
+		return err
+	}
+
+	var matched int
+
+	for _, lock := range locks {
+		if lock.Lock == nil || (opts.LockID != "" && lock.Lock.ID != opts.LockID) {
+			continue
+		}
+
+		matched++
+
+		prompt := fmt.Sprintf("Lock %s held by %s for %s on unit %s will be force-released. Do you want to continue?", lock.Lock.ID, lock.Lock.Who, lock.Lock.Operation, lock.Path)
+		if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.TerragruntOptions); err != nil {
+			return err
+		} else if !yes {
+			l.Infof("Lock %s of unit %s was not released", lock.Lock.ID, lock.Path)
+
+			continue
+		}
+
+		if err := lock.remoteState.ReleaseLock(ctx, l, lock.opts); err != nil {
+			return errors.Errorf("failed to release lock %s of unit %s: %w", lock.Lock.ID, lock.Path, err)
+		}
+
+		l.Infof("Released lock %s of unit %s", lock.Lock.ID, lock.Path)
+	}
+
+	if matched == 0 && opts.LockID != "" {
+		return errors.Errorf("lock %s not found", opts.LockID)
+	}
+
+	return locks.Err()
+}
+
+// findLocks returns the locked units and the units whose lock could not be read, sorted by path.
+func findLocks(ctx context.Context, l log.Logger, opts *Options) (UnitLocks, error) {
+	cfgs, err := discovery.NewDiscovery(opts.WorkingDir).Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var locks UnitLocks
+
+	for _, cfg := range cfgs.Filter(discovery.ConfigTypeUnit).Sort() {
+		lock, err := getUnitLock(ctx, l, opts, cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if lock != nil {
+			locks = append(locks, lock)
+		}
+	}
+
+	return locks, nil
+}
+
+// getUnitLock returns the state lock of the unit in the given directory. Returns nil if the unit has
+// no `remote_state` block or its state is not locked.
+func getUnitLock(ctx context.Context, l log.Logger, opts *Options, unitDir string) (*UnitLock, error) {
+	l, unitOpts, err := opts.CloneWithConfigPath(l, filepath.Join(unitDir, config.DefaultTerragruntConfigPath))
+	if err != nil {
+		return nil, err
+	}
+
+	relPath, err := filepath.Rel(opts.WorkingDir, unitDir)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	lock := &UnitLock{Path: filepath.ToSlash(relPath), opts: unitOpts}
+
+	remoteState, err := config.ParseRemoteState(ctx, l, unitOpts)
+	if err != nil {
+		lock.Error = err.Error()
+
+		return lock, nil
+	}
+
+	if remoteState == nil {
+		l.Debugf("Did not find remote `remote_state` block in the config, skipping unit %s", unitDir)
+
+		return nil, nil
+	}
+
+	lock.Backend = remoteState.BackendName
+	lock.remoteState = remoteState
+
+	info, err := remoteState.GetLock(ctx, l, unitOpts)
+	if err != nil {
+		lock.Error = err.Error()
+
+		return lock, nil
+	}
+
+	if info == nil {
+		return nil, nil
+	}
+
+	lock.Lock = info
+	lock.Age = info.Age().Round(time.Second).String()
+
+	return lock, nil
+}
+
+// Err returns an error if the lock of any unit could not be read.
+func (locks UnitLocks) Err() error {
+	var failed int
+
+	for _, lock := range locks {
+		if lock.Error != "" {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	return errors.Errorf("failed to read the state lock of %d unit(s)", failed)
+}
+
+func outputJSON(opts *Options, locks UnitLocks) error {
+	if locks == nil {
+		locks = UnitLocks{}
+	}
+
+	jsonBytes, err := json.MarshalIndent(locks, "", "  ")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := opts.Writer.Write(append(jsonBytes, '\n')); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func outputText(opts *Options, locks UnitLocks) error {
+	if len(locks) == 0 {
+		_, err := fmt.Fprintln(opts.Writer, "No state locks found.")
+
+		return errors.New(err)
+	}
+
+	out := new(bytes.Buffer)
+	tabOut := tabwriter.NewWriter(out, tabMinWidth, tabWidth, tabPadding, ' ', 0)
+
+	fmt.Fprintln(tabOut, "UNIT\tBACKEND\tID\tWHO\tOPERATION\tAGE")
+
+	for _, lock := range locks {
+		if lock.Error != "" {
+			fmt.Fprintf(tabOut, "%s\t%s\terror: %s\t\t\t\n", lock.Path, lock.Backend, lock.Error)
+
+			continue
+		}
+
+		fmt.Fprintf(tabOut, "%s\t%s\t%s\t%s\t%s\t%s\n", lock.Path, lock.Backend, lock.Lock.ID, lock.Lock.Who, lock.Lock.Operation, lock.Age)
+	}
+
+	if err := tabOut.Flush(); err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := opts.Writer.Write(out.Bytes()); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package lock_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/lock"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAndReleaseWithoutLocks(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		"no-remote-state/terragrunt.hcl": "",
+		"local/terragrunt.hcl": `
+remote_state {
+  backend = "local"
+  config = {
+    path = "terraform.tfstate"
+  }
+}
+`,
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, path), []byte(content), 0644))
+	}
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = tmpDir
+	tgOpts.NonInteractive = true
+
+	var output bytes.Buffer
+
+	opts := lock.NewOptions(tgOpts)
+	opts.Writer = &output
+
+	require.NoError(t, lock.List(t.Context(), logger.CreateLogger(), opts))
+	assert.Equal(t, "No state locks found.\n", output.String())
+
+	output.Reset()
+	opts.Format = lock.FormatJSON
+
+	require.NoError(t, lock.List(t.Context(), logger.CreateLogger(), opts))
+	assert.JSONEq(t, "[]", output.String())
+
+	opts.LockID = "8d2c0c5e"
+	require.Error(t, lock.Release(t.Context(), logger.CreateLogger(), opts))
+}
+
+func TestOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	opts := lock.NewOptions(options.NewTerragruntOptions())
+	require.NoError(t, opts.Validate())
+
+	opts.Format = "yaml"
+	require.Error(t, opts.Validate())
+}
@@ -0,0 +1,44 @@
+package lock
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	// FormatText outputs the state locks in text format.
+	FormatText = "text"
+
+	// FormatJSON outputs the state locks in JSON format.
+	FormatJSON = "json"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+
+	// JSON determines if the output should be in JSON format.
+	// Alias for --format=json.
+	JSON bool
+
+	// LockID limits the release to the lock with the given ID.
+	LockID string
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Format:            FormatText,
+	}
+}
+
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+		return nil
+	default:
+		return errors.New("invalid format: " + o.Format)
+	}
+}
@@ -0,0 +1,68 @@
+package mv
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "mv"
+
+	DetectFlagName = "detect"
+	RefFlagName    = "ref"
+	ForceFlagName  = "force"
+
+	usageText = "terragrunt backend mv [options] <old-unit-path> <new-unit-path>\n   terragrunt backend mv --detect [--ref <git-ref>]"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        DetectFlagName,
+			EnvVars:     tgPrefix.EnvVars(DetectFlagName),
+			Destination: &opts.Detect,
+			Usage:       "Detect the moved units by comparing the working tree with a git ref, and move the state of each of them.",
+		}),
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        RefFlagName,
+			EnvVars:     tgPrefix.EnvVars(RefFlagName),
+			Destination: &opts.Ref,
+			Usage:       "The git ref the working tree is compared with when detecting moved units.",
+			DefaultText: DefaultRef,
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ForceFlagName,
+			EnvVars:     tgPrefix.EnvVars(ForceFlagName),
+			Destination: &opts.Force,
+			Usage:       "Move the state even if the bucket is not versioned.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:      CommandName,
+		Usage:     "Move the OpenTofu/Terraform state of units that were renamed or moved to their new state location.",
+		UsageText: usageText,
+		Flags:     NewFlags(cmdOpts, nil),
+		Action: func(ctx *cli.Context) error {
+			if cmdOpts.Detect {
+				return Detect(ctx, l, cmdOpts)
+			}
+
+			oldPath, newPath := ctx.Args().First(), ctx.Args().Second()
+			if oldPath == "" || newPath == "" {
+				return errors.New(usageText)
+			}
+
+			return Run(ctx, l, cmdOpts, oldPath, newPath)
+		},
+	}
+}
@@ -0,0 +1,269 @@
+// Package mv provides the ability to move the state of units that were renamed or moved to their new state location.
+package mv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// Move is a unit moved from `OldPath` to `NewPath`.
+type Move struct {
+	OldPath string
+	NewPath string
+}
+
+type Moves []*Move
+
+// Run moves the state of the unit from its old location to its new one. The new unit must exist. If the old unit
+// does not exist anymore, its remote state is evaluated from the config of the new unit, as if it were still
+// located at the old path.
+func Run(ctx context.Context, l log.Logger, opts *Options, oldPath, newPath string) error {
+	oldPath, err := util.CanonicalPath(oldPath, opts.WorkingDir)
+	if err != nil {
+		return err
+	}
+
+	newPath, err = util.CanonicalPath(newPath, opts.WorkingDir)
+	if err != nil {
+		return err
+	}
+
+	newConfigPath := filepath.Join(newPath, config.DefaultTerragruntConfigPath)
+	if !util.FileExists(newConfigPath) {
+		return errors.Errorf("new unit not found at %s", newPath)
+	}
+
+	oldConfigPath := filepath.Join(oldPath, config.DefaultTerragruntConfigPath)
+	if !util.FileExists(oldConfigPath) {
+		l.Debugf("Old unit not found at %s, evaluating it from the config of %s", oldPath, newPath)
+
+		oldConfigPath = newConfigPath
+	}
+
+	oldConfig, err := os.ReadFile(oldConfigPath)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	return moveState(ctx, l, opts, &Move{OldPath: oldPath, NewPath: newPath}, string(oldConfig))
+}
+
+// Detect compares the working tree with `opts.Ref`, detects the units that were moved, and moves their state.
+// The remote state of the old location is evaluated from the config as of `opts.Ref`.
+func Detect(ctx context.Context, l log.Logger, opts *Options) error {
+	changes, err := shell.GitDiffNameStatus(ctx, l, opts.TerragruntOptions, opts.WorkingDir, opts.Ref)
+	if err != nil {
+		return err
+	}
+
+	cfgs, err := discovery.NewDiscovery(opts.WorkingDir).Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	units := make(map[string]bool)
+
+	for _, cfg := range cfgs.Filter(discovery.ConfigTypeUnit) {
+		units[cfg.Path] = true
+	}
+
+	var moves Moves
+
+	for _, move := range DetectMoves(changes) {
+		if units[move.NewPath] {
+			moves = append(moves, move)
+		}
+	}
+
+	if len(moves) == 0 {
+		l.Infof("No moved units detected since %s", opts.Ref)
+
+		return nil
+	}
+
+	for _, move := range moves {
+		oldConfig, err := shell.GitShowFile(ctx, l, opts.TerragruntOptions, opts.Ref, filepath.Join(move.OldPath, config.DefaultTerragruntConfigPath))
+		if err != nil {
+			return err
+		}
+
+		if err := moveState(ctx, l, opts, move, oldConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DetectMoves pairs the units removed from the old locations with the units added to the new locations. A unit is
+// considered moved if its files were renamed from the old unit directory to the new one; when several new units
+// match, the one that received the most files wins.
+func DetectMoves(changes []shell.GitChange) Moves {
+	var (
+		removed = make(map[string]bool)
+		added   = make(map[string]bool)
+	)
+
+	for _, change := range changes {
+		switch change.Status {
+		case "D":
+			if isConfigFile(change.Path) {
+				removed[filepath.Dir(change.Path)] = true
+			}
+		case "A":
+			if isConfigFile(change.Path) {
+				added[filepath.Dir(change.Path)] = true
+			}
+		case "R":
+			if isConfigFile(change.OldPath) {
+				removed[filepath.Dir(change.OldPath)] = true
+			}
+
+			if isConfigFile(change.Path) {
+				added[filepath.Dir(change.Path)] = true
+			}
+		}
+	}
+
+	type candidate struct {
+		Move
+		votes int
+	}
+
+	votes := make(map[Move]int)
+
+	for _, change := range changes {
+		if change.Status != "R" {
+			continue
+		}
+
+		for oldPath := range removed {
+			relPath, ok := strings.CutPrefix(change.OldPath, oldPath+string(filepath.Separator))
+			if !ok {
+				continue
+			}
+
+			newPath, ok := strings.CutSuffix(change.Path, string(filepath.Separator)+relPath)
+			if ok && added[newPath] && !removed[newPath] && !added[oldPath] {
+				votes[Move{OldPath: oldPath, NewPath: newPath}]++
+			}
+		}
+	}
+
+	candidates := make([]candidate, 0, len(votes))
+
+	for move, count := range votes {
+		candidates = append(candidates, candidate{Move: move, votes: count})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].votes != candidates[j].votes {
+			return candidates[i].votes > candidates[j].votes
+		}
+
+		if candidates[i].OldPath != candidates[j].OldPath {
+			return candidates[i].OldPath < candidates[j].OldPath
+		}
+
+		return candidates[i].NewPath < candidates[j].NewPath
+	})
+
+	var (
+		moves   Moves
+		matched = make(map[string]bool)
+	)
+
+	for _, candidate := range candidates {
+		if matched[candidate.OldPath] || matched[candidate.NewPath] {
+			continue
+		}
+
+		matched[candidate.OldPath] = true
+		matched[candidate.NewPath] = true
+
+		moves = append(moves, &Move{OldPath: candidate.OldPath, NewPath: candidate.NewPath})
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].OldPath < moves[j].OldPath
+	})
+
+	return moves
+}
+
+// moveState evaluates the remote state of the unit at its old location from the given config, and at its new
+// location, and moves the state, including its lock table entries, with the `Migrate` support of the backend.
+func moveState(ctx context.Context, l log.Logger, opts *Options, move *Move, oldConfig string) error {
+	l, newOpts, err := opts.CloneWithConfigPath(l, filepath.Join(move.NewPath, config.DefaultTerragruntConfigPath))
+	if err != nil {
+		return err
+	}
+
+	newRemoteState, err := config.ParseRemoteState(ctx, l, newOpts)
+	if err != nil {
+		return err
+	}
+
+	if newRemoteState == nil {
+		return errors.Errorf("unit %s does not have a remote_state block", move.NewPath)
+	}
+
+	l, oldOpts, err := opts.CloneWithConfigPath(l, filepath.Join(move.OldPath, config.DefaultTerragruntConfigPath))
+	if err != nil {
+		return err
+	}
+
+	oldRemoteState, err := config.ParseRemoteStateString(ctx, l, oldOpts, oldConfig)
+	if err != nil {
+		return err
+	}
+
+	if oldRemoteState == nil {
+		return errors.Errorf("unit %s did not have a remote_state block", move.OldPath)
+	}
+
+	if oldRemoteState.BackendName != newRemoteState.BackendName {
+		return errors.Errorf("unit %s moved from the %s backend to the %s backend, use `backend migrate` instead", move.NewPath, oldRemoteState.BackendName, newRemoteState.BackendName)
+	}
+
+	if reflect.DeepEqual(oldRemoteState.BackendConfig, newRemoteState.BackendConfig) {
+		l.Infof("The state location of unit %s did not change, nothing to move", move.NewPath)
+
+		return nil
+	}
+
+	if !opts.Force {
+		enabled, err := oldRemoteState.IsVersionControlEnabled(ctx, l, oldOpts)
+		if err != nil && !errors.As(err, new(backend.BucketDoesNotExistError)) {
+			return err
+		}
+
+		if !enabled {
+			return errors.Errorf("bucket is not versioned, refusing to move backend state. If you are sure you want to move the backend state anyways, use the --%s flag", ForceFlagName)
+		}
+	}
+
+	prompt := fmt.Sprintf("The %s backend state of unit %s will be moved to the state location of unit %s. Do you want to continue?", oldRemoteState.BackendName, move.OldPath, move.NewPath)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.TerragruntOptions); err != nil || !yes {
+		return err
+	}
+
+	return oldRemoteState.Migrate(ctx, l, oldOpts, newOpts, newRemoteState)
+}
+
+func isConfigFile(path string) bool {
+	return filepath.Base(path) == config.DefaultTerragruntConfigPath
+}
@@ -0,0 +1,87 @@
+package mv_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/mv"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectMoves(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.FromSlash("/live")
+	join := func(elem ...string) string {
+		return filepath.Join(append([]string{root}, elem...)...)
+	}
+
+	changes := []shell.GitChange{
+		// vpc was moved to network/vpc, together with its OpenTofu code.
+		{Status: "R", OldPath: join("vpc", "terragrunt.hcl"), Path: join("network", "vpc", "terragrunt.hcl")},
+		{Status: "R", OldPath: join("vpc", "main.tf"), Path: join("network", "vpc", "main.tf")},
+		// The config of app is identical to the config of db, so git may pair it with the wrong new unit,
+		// but the OpenTofu code still points to the right one.
+		{Status: "R", OldPath: join("app", "terragrunt.hcl"), Path: join("services", "db", "terragrunt.hcl")},
+		{Status: "R", OldPath: join("app", "main.tf"), Path: join("services", "app", "main.tf")},
+		{Status: "R", OldPath: join("app", "outputs.tf"), Path: join("services", "app", "outputs.tf")},
+		{Status: "A", Path: join("services", "app", "terragrunt.hcl")},
+		// legacy was deleted and cache was added, they are unrelated.
+		{Status: "D", Path: join("legacy", "terragrunt.hcl")},
+		{Status: "A", Path: join("cache", "terragrunt.hcl")},
+		{Status: "M", Path: join("root.hcl")},
+	}
+
+	assert.Equal(t, mv.Moves{
+		{OldPath: join("app"), NewPath: join("services", "app")},
+		{OldPath: join("vpc"), NewPath: join("network", "vpc")},
+	}, mv.DetectMoves(changes))
+}
+
+func TestGitDiffNameStatus(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+
+	// Resolve symlinks, such as /tmp on macOS, the same way git does.
+	repoDir, err := filepath.EvalSymlinks(repoDir)
+	require.NoError(t, err)
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = repoDir
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "vpc"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "vpc", "terragrunt.hcl"), []byte("inputs = {\n  name = \"vpc\"\n}\n"), 0644))
+
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "init")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "network"), 0755))
+	git("mv", "vpc", filepath.Join("network", "vpc"))
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(repoDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	changes, err := shell.GitDiffNameStatus(t.Context(), logger.CreateLogger(), opts, repoDir, mv.DefaultRef)
+	require.NoError(t, err)
+
+	assert.Equal(t, []shell.GitChange{{
+		Status:  "R",
+		OldPath: filepath.Join(repoDir, "vpc", "terragrunt.hcl"),
+		Path:    filepath.Join(repoDir, "network", "vpc", "terragrunt.hcl"),
+	}}, changes)
+
+	assert.Equal(t, mv.Moves{{OldPath: filepath.Join(repoDir, "vpc"), NewPath: filepath.Join(repoDir, "network", "vpc")}}, mv.DetectMoves(changes))
+}
@@ -0,0 +1,28 @@
+package mv
+
+import (
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+// DefaultRef is the git ref the working tree is compared with to detect moved units.
+const DefaultRef = "HEAD"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Ref is the git ref the working tree is compared with to detect moved units.
+	Ref string
+
+	// Detect determines if the moved units should be detected from git instead of given as arguments.
+	Detect bool
+
+	// Force determines if the state should be moved even if the bucket is not versioned.
+	Force bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Ref:               DefaultRef,
+	}
+}
@@ -0,0 +1,77 @@
+package orphans
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "orphans"
+
+	FormatFlagName = "format"
+
+	JSONFlagName  = "json"
+	JSONFlagAlias = "j"
+
+	PrefixFlagName = "prefix"
+	DeleteFlagName = "delete"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format for the orphaned state objects. Valid values: text, json.",
+			DefaultText: FormatText,
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Aliases:     []string{JSONFlagAlias},
+			Destination: &opts.JSON,
+			Usage:       "Output in JSON format (equivalent to --format=json).",
+		}),
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        PrefixFlagName,
+			EnvVars:     tgPrefix.EnvVars(PrefixFlagName),
+			Destination: &opts.Prefix,
+			Usage:       "Only look for orphaned state objects whose keys start with the given prefix.",
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        DeleteFlagName,
+			EnvVars:     tgPrefix.EnvVars(DeleteFlagName),
+			Destination: &opts.Delete,
+			Usage:       "Delete the orphaned state objects, asking for confirmation for each of them.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Find the state objects in the backend buckets of all discovered units that no unit owns anymore.",
+		Flags: NewFlags(cmdOpts, nil),
+		Before: func(ctx *cli.Context) error {
+			if cmdOpts.JSON {
+				cmdOpts.Format = FormatJSON
+			}
+
+			if err := cmdOpts.Validate(); err != nil {
+				return cli.NewExitError(err, cli.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx *cli.Context) error {
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package orphans
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	// FormatText outputs the orphaned state objects in text format.
+	FormatText = "text"
+
+	// FormatJSON outputs the orphaned state objects in JSON format.
+	FormatJSON = "json"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+
+	// Prefix limits the listed state objects to the keys with the given prefix.
+	Prefix string
+
+	// JSON determines if the output should be in JSON format.
+	// Alias for --format=json.
+	JSON bool
+
+	// Delete deletes the orphaned state objects, asking for confirmation for each of them.
+	Delete bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Format:            FormatText,
+	}
+}
+
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+		return nil
+	default:
+		return errors.New("invalid format: " + o.Format)
+	}
+}
@@ -0,0 +1,248 @@
+// Package orphans provides the ability to find, and optionally delete, the state objects in the backend buckets
+// that are not owned by any of the discovered units anymore.
+package orphans
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	tabMinWidth = 0
+	tabWidth    = 8
+	tabPadding  = 2
+)
+
+// Orphan is a state object that is not owned by any of the discovered units.
+type Orphan struct {
+	*backend.StateObject
+
+	Backend string `json:"backend"`
+
+	source *Bucket
+}
+
+type Orphans []*Orphan
+
+// Bucket is a backend bucket used by one or more of the discovered units.
+type Bucket struct {
+	Backend   string
+	Name      string
+	Locations []*backend.StateLocation
+
+	// remoteState and opts belong to the first unit using the bucket, and are used to access it.
+	remoteState *remotestate.RemoteState
+	opts        *options.TerragruntOptions
+}
+
+type Buckets []*Bucket
+
+// Run lists the orphaned state objects in the backend buckets of the discovered units, and deletes them if
+// `opts.Delete` is set.
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	buckets, err := findBuckets(ctx, l, opts)
+	if err != nil {
+		return err
+	}
+
+	var orphans Orphans
+
+	for _, bucket := range buckets {
+		objects, err := bucket.remoteState.ListStateObjects(ctx, l, bucket.opts, opts.Prefix)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range FindOrphans(objects, bucket.Locations) {
+			orphans = append(orphans, &Orphan{StateObject: obj, Backend: bucket.Backend, source: bucket})
+		}
+	}
+
+	switch opts.Format {
+	case FormatJSON:
+		err = outputJSON(opts, orphans)
+	default:
+		err = outputText(opts, orphans)
+	}
+
+	if err != nil || !opts.Delete {
+		return err
+	}
+
+	return deleteOrphans(ctx, l, opts, orphans)
+}
+
+// FindOrphans returns the state objects that are not owned by any of the given state locations, sorted by key. The
+// state snapshots kept in the bucket with `--state-snapshot-bucket-prefix` are not orphans, as they are backups of
+// the state of their unit, and are skipped whatever their prefix.
+func FindOrphans(objects []*backend.StateObject, locations []*backend.StateLocation) []*backend.StateObject {
+	var orphans []*backend.StateObject
+
+	for _, obj := range objects {
+		if snapshot.IsName(path.Base(obj.Key)) {
+			continue
+		}
+
+		owned := false
+
+		for _, loc := range locations {
+			if loc.Owns(obj) {
+				owned = true
+
+				break
+			}
+		}
+
+		if !owned {
+			orphans = append(orphans, obj)
+		}
+	}
+
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphans[i].Key < orphans[j].Key
+	})
+
+	return orphans
+}
+
+// findBuckets evaluates the remote state of the discovered units and groups their state locations by bucket.
+// Units without a `remote_state` block, or whose backend does not store states in a bucket, are skipped. A unit
+// whose remote state cannot be evaluated is an error, as the state objects it owns would be reported as orphaned.
+func findBuckets(ctx context.Context, l log.Logger, opts *Options) (Buckets, error) {
+	cfgs, err := discovery.NewDiscovery(opts.WorkingDir).Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		buckets Buckets
+		index   = make(map[string]*Bucket)
+	)
+
+	for _, cfg := range cfgs.Filter(discovery.ConfigTypeUnit).Sort() {
+		l, unitOpts, err := opts.CloneWithConfigPath(l, filepath.Join(cfg.Path, config.DefaultTerragruntConfigPath))
+		if err != nil {
+			return nil, err
+		}
+
+		remoteState, err := config.ParseRemoteState(ctx, l, unitOpts)
+		if err != nil {
+			return nil, errors.Errorf("failed to evaluate the remote state of unit %s: %w", cfg.Path, err)
+		}
+
+		if remoteState == nil {
+			l.Debugf("Did not find remote `remote_state` block in the config, skipping unit %s", cfg.Path)
+
+			continue
+		}
+
+		loc, err := remoteState.GetStateLocation(ctx, l, unitOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		if loc == nil {
+			l.Debugf("The %s backend does not store states in a bucket, skipping unit %s", remoteState.BackendName, cfg.Path)
+
+			continue
+		}
+
+		key := remoteState.BackendName + "://" + loc.Bucket
+
+		bucket, ok := index[key]
+		if !ok {
+			bucket = &Bucket{
+				Backend:     remoteState.BackendName,
+				Name:        loc.Bucket,
+				remoteState: remoteState,
+				opts:        unitOpts,
+			}
+			index[key] = bucket
+			buckets = append(buckets, bucket)
+		}
+
+		bucket.Locations = append(bucket.Locations, loc)
+	}
+
+	return buckets, nil
+}
+
+func deleteOrphans(ctx context.Context, l log.Logger, opts *Options, orphans Orphans) error {
+	for _, orphan := range orphans {
+		prompt := fmt.Sprintf("State object %s in %s bucket %s will be deleted. Do you want to continue?", orphan.Key, orphan.Backend, orphan.Bucket)
+		if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.TerragruntOptions); err != nil {
+			return err
+		} else if !yes {
+			continue
+		}
+
+		if err := orphan.source.remoteState.DeleteStateObject(ctx, l, orphan.source.opts, orphan.Key); err != nil {
+			return errors.Errorf("failed to delete state object %s in bucket %s: %w", orphan.Key, orphan.Bucket, err)
+		}
+
+		l.Infof("Deleted state object %s in bucket %s", orphan.Key, orphan.Bucket)
+	}
+
+	return nil
+}
+
+func outputJSON(opts *Options, orphans Orphans) error {
+	if orphans == nil {
+		orphans = Orphans{}
+	}
+
+	jsonBytes, err := json.MarshalIndent(orphans, "", "  ")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := opts.Writer.Write(append(jsonBytes, '\n')); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func outputText(opts *Options, orphans Orphans) error {
+	if len(orphans) == 0 {
+		_, err := fmt.Fprintln(opts.Writer, "No orphaned state objects found.")
+
+		return errors.New(err)
+	}
+
+	out := new(bytes.Buffer)
+	tabOut := tabwriter.NewWriter(out, tabMinWidth, tabWidth, tabPadding, ' ', 0)
+
+	fmt.Fprintln(tabOut, "BACKEND\tBUCKET\tKEY\tSIZE\tLAST MODIFIED")
+
+	for _, orphan := range orphans {
+		fmt.Fprintf(tabOut, "%s\t%s\t%s\t%d\t%s\n", orphan.Backend, orphan.Bucket, orphan.Key, orphan.Size, orphan.LastModified.Format(time.RFC3339))
+	}
+
+	if err := tabOut.Flush(); err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := opts.Writer.Write(out.Bytes()); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
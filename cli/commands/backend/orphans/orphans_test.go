@@ -0,0 +1,85 @@
+package orphans_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/orphans"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOrphans(t *testing.T) {
+	t.Parallel()
+
+	locations := []*backend.StateLocation{
+		{Bucket: "my-bucket", KeyPatterns: []string{"app/terraform.tfstate", "env:/*/app/terraform.tfstate"}},
+		{Bucket: "my-bucket", KeyPatterns: []string{"db/terraform.tfstate", "env:/*/db/terraform.tfstate"}},
+	}
+
+	objects := []*backend.StateObject{
+		{Bucket: "my-bucket", Key: "old-app/terraform.tfstate"},
+		{Bucket: "my-bucket", Key: "app/terraform.tfstate"},
+		{Bucket: "my-bucket", Key: "env:/dev/db/terraform.tfstate"},
+		{Bucket: "my-bucket", Key: "env:/dev/cache/terraform.tfstate"},
+		// The snapshots of the state of a unit, next to its live state, and of a unit that no longer exists.
+		{Bucket: "my-bucket", Key: "snapshots/app/terraform.tfstate/20250304T050607.890Z-apply.tfstate"},
+		{Bucket: "my-bucket", Key: "snapshots/old-app/terraform.tfstate/20250304T050607.890Z-destroy.tfstate"},
+	}
+
+	var keys []string
+
+	for _, obj := range orphans.FindOrphans(objects, locations) {
+		keys = append(keys, obj.Key)
+	}
+
+	assert.Equal(t, []string{"env:/dev/cache/terraform.tfstate", "old-app/terraform.tfstate"}, keys)
+}
+
+func TestRunWithoutBuckets(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		"no-remote-state/terragrunt.hcl": "",
+		"local/terragrunt.hcl": `
+remote_state {
+  backend = "local"
+  config = {
+    path = "terraform.tfstate"
+  }
+}
+`,
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, path), []byte(content), 0644))
+	}
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = tmpDir
+	tgOpts.NonInteractive = true
+
+	var output bytes.Buffer
+
+	opts := orphans.NewOptions(tgOpts)
+	opts.Writer = &output
+
+	require.NoError(t, orphans.Run(t.Context(), logger.CreateLogger(), opts))
+	assert.Equal(t, "No orphaned state objects found.\n", output.String())
+
+	output.Reset()
+	opts.Format = orphans.FormatJSON
+
+	require.NoError(t, orphans.Run(t.Context(), logger.CreateLogger(), opts))
+	assert.JSONEq(t, "[]", output.String())
+}
@@ -0,0 +1,150 @@
+package state
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/common/runall"
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "state"
+
+	PullCommandName    = "pull"
+	PushCommandName    = "push"
+	ListCommandName    = "list"
+	RestoreCommandName = "restore"
+
+	OutFlagName            = "out"
+	ForceStatePushFlagName = "force"
+	ListSnapshotsFlagName  = "list"
+
+	pushUsageText = "terragrunt backend state push [options] <state-file>"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Pull, push and list OpenTofu/Terraform state stored in the backend.",
+		Subcommands: cli.Commands{
+			NewPullCommand(l, opts),
+			NewPushCommand(l, opts),
+			NewListCommand(l, opts),
+			NewRestoreCommand(l, opts),
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
+
+func NewPullFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	flags := cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        OutFlagName,
+			EnvVars:     tgPrefix.EnvVars(OutFlagName),
+			Usage:       "Write the state to the given file instead of stdout. With --all, the state of each unit is written to <out>/<unit-path>/terraform.tfstate.",
+			Destination: &opts.BackendStateOut,
+		}),
+	}
+
+	return append(flags, run.NewFlags(l, opts, nil).Filter(run.ConfigFlagName, run.DownloadDirFlagName)...)
+}
+
+func NewPullCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmd := &cli.Command{
+		Name:  PullCommandName,
+		Usage: "Pull the OpenTofu/Terraform state from the backend.",
+		Flags: NewPullFlags(l, opts, nil),
+		Action: func(ctx *cli.Context) error {
+			return Pull(ctx, l, opts.OptionsFromContext(ctx))
+		},
+	}
+
+	return runall.WrapCommand(l, opts, cmd, run.Run, true)
+}
+
+func NewPushFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	flags := cli.Flags{
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ForceStatePushFlagName,
+			EnvVars:     tgPrefix.EnvVars(ForceStatePushFlagName),
+			Usage:       "Push the state even if the lineage or serial checks fail.",
+			Destination: &opts.ForceBackendStatePush,
+		}),
+	}
+
+	return append(flags, run.NewFlags(l, opts, nil).Filter(run.ConfigFlagName, run.DownloadDirFlagName)...)
+}
+
+func NewPushCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmd := &cli.Command{
+		Name:      PushCommandName,
+		Usage:     "Push the OpenTofu/Terraform state to the backend. With --all, <state-file> is a directory laid out the same way as `backend state pull --all --out`.",
+		UsageText: pushUsageText,
+		Flags:     NewPushFlags(l, opts, nil),
+		Action: func(ctx *cli.Context) error {
+			statePath := ctx.Args().First()
+			if statePath == "" {
+				return errors.New(pushUsageText)
+			}
+
+			return Push(ctx, l, opts.OptionsFromContext(ctx), statePath)
+		},
+	}
+
+	return runall.WrapCommand(l, opts, cmd, run.Run, true)
+}
+
+func NewListCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmd := &cli.Command{
+		Name:  ListCommandName,
+		Usage: "List the resources in the OpenTofu/Terraform state.",
+		Flags: run.NewFlags(l, opts, nil).Filter(run.ConfigFlagName, run.DownloadDirFlagName),
+		Action: func(ctx *cli.Context) error {
+			return List(ctx, l, opts.OptionsFromContext(ctx))
+		},
+	}
+
+	return runall.WrapCommand(l, opts, cmd, run.Run, true)
+}
+
+func NewRestoreFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	flags := cli.Flags{
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ListSnapshotsFlagName,
+			EnvVars:     tgPrefix.EnvVars(ListSnapshotsFlagName),
+			Usage:       "List the state snapshots instead of restoring one.",
+			Destination: &opts.ListStateSnapshots,
+		}),
+	}
+
+	return append(flags, run.NewFlags(l, opts, nil).Filter(
+		run.ConfigFlagName,
+		run.DownloadDirFlagName,
+		run.StateSnapshotDirFlagName,
+		run.StateSnapshotBucketPrefixFlagName,
+		run.StateSnapshotRetentionFlagName,
+	)...)
+}
+
+func NewRestoreCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmd := &cli.Command{
+		Name:      RestoreCommandName,
+		Usage:     "Restore the OpenTofu/Terraform state from a snapshot taken before a mutating command. Without <snapshot>, the latest snapshot is restored.",
+		UsageText: "terragrunt backend state restore [options] [<snapshot>]",
+		Flags:     NewRestoreFlags(l, opts, nil),
+		Action: func(ctx *cli.Context) error {
+			return Restore(ctx, l, opts.OptionsFromContext(ctx), ctx.Args().First())
+		},
+	}
+
+	return runall.WrapCommand(l, opts, cmd, run.Run, true)
+}
@@ -0,0 +1,249 @@
+// Package state provides the ability to pull, push and list the OpenTofu/Terraform state of units.
+package state
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	// DefaultStateFileName is the name of the state file of each unit in the directory used with `--all`.
+	DefaultStateFileName = "terraform.tfstate"
+
+	// State files may contain secrets, so they are only readable by the owner.
+	ownerReadWritePerms = 0600
+)
+
+// ActionFunc is called with the remote state of a unit prepared by `RunWithRemoteState`.
+type ActionFunc func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error
+
+// Pull pulls the state of the unit and writes it to `opts.BackendStateOut`, or to stdout if it is not set.
+// When running with `--all`, `opts.BackendStateOut` is a directory where the state of each unit is written
+// to `<unit-path>/terraform.tfstate`.
+func Pull(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	outPath := opts.BackendStateOut
+
+	if opts.RunAll {
+		if outPath == "" {
+			return errors.Errorf("the --%s flag is required when running with --all", OutFlagName)
+		}
+
+		outPath = unitStateFilePath(opts, outPath)
+	}
+
+	return RunWithRemoteState(ctx, l, opts, tf.CommandNamePull, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error {
+		stateFile, err := remoteState.PullState(ctx, l, opts)
+		if err != nil {
+			return err
+		}
+
+		defer os.Remove(stateFile) //nolint:errcheck
+
+		data, err := os.ReadFile(stateFile)
+		if err != nil {
+			return errors.New(err)
+		}
+
+		if outPath == "" {
+			_, err := opts.Writer.Write(data)
+
+			return errors.New(err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(outPath), os.ModePerm); err != nil {
+			return errors.New(err)
+		}
+
+		l.Infof("Writing state to %s", outPath)
+
+		return errors.New(os.WriteFile(outPath, data, ownerReadWritePerms))
+	})
+}
+
+// Push pushes the given state file to the backend of the unit. When running with `--all`, `statePath` is a directory
+// from which the state of each unit is read from `<unit-path>/terraform.tfstate`; units without a state file are skipped.
+func Push(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, statePath string) error {
+	statePath, err := util.CanonicalPath(statePath, opts.RootWorkingDir)
+	if err != nil {
+		return err
+	}
+
+	if opts.RunAll {
+		statePath = unitStateFilePath(opts, statePath)
+	}
+
+	if !util.FileExists(statePath) {
+		if opts.RunAll {
+			l.Debugf("State file %s does not exist, skipping unit %s", statePath, opts.WorkingDir)
+
+			return nil
+		}
+
+		return errors.Errorf("state file %s does not exist", statePath)
+	}
+
+	return RunWithRemoteState(ctx, l, opts, tf.CommandNamePush, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error {
+		prompt := fmt.Sprintf("The %s backend state of unit %s will be overwritten with %s. Do you want to continue?", remoteState.BackendName, opts.OriginalTerragruntConfigPath, statePath)
+		if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil || !yes {
+			return err
+		}
+
+		return remoteState.PushState(ctx, l, opts, statePath, opts.ForceBackendStatePush)
+	})
+}
+
+// List lists the resources in the state of the unit. When running with `--all`, each address is prefixed with the unit path.
+func List(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	unitPath := unitRelPath(opts)
+
+	return RunWithRemoteState(ctx, l, opts, tf.CommandNameList, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, _ *remotestate.RemoteState) error {
+		output, err := tf.RunCommandWithOutput(ctx, l, opts, tf.CommandNameState, tf.CommandNameList)
+		if err != nil {
+			return err
+		}
+
+		if !opts.RunAll {
+			_, err := opts.Writer.Write(output.Stdout.Bytes())
+
+			return errors.New(err)
+		}
+
+		var buf bytes.Buffer
+
+		scanner := bufio.NewScanner(&output.Stdout)
+		for scanner.Scan() {
+			if line := scanner.Text(); line != "" {
+				fmt.Fprintf(&buf, "%s: %s\n", unitPath, line)
+			}
+		}
+
+		_, err = opts.Writer.Write(buf.Bytes())
+
+		return errors.New(err)
+	})
+}
+
+// Restore pushes the state snapshot with the given name to the backend of the unit, or the latest snapshot if the name
+// is empty. The current state is snapshotted first, so the restore itself can be undone. If `opts.ListStateSnapshots`
+// is set, the snapshots are listed instead. When running with `--all`, units without snapshots are skipped.
+func Restore(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, name string) error {
+	unitPath := unitRelPath(opts)
+
+	return RunWithRemoteState(ctx, l, opts, tf.CommandNamePush, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) error {
+		store, err := snapshot.NewStore(ctx, l, opts, remoteState)
+		if err != nil {
+			return err
+		}
+
+		if opts.ListStateSnapshots {
+			names, err := store.List(ctx, l)
+			if err != nil {
+				return err
+			}
+
+			var buf bytes.Buffer
+
+			for _, name := range names {
+				if opts.RunAll {
+					fmt.Fprintf(&buf, "%s: %s\n", unitPath, name)
+				} else {
+					fmt.Fprintln(&buf, name)
+				}
+			}
+
+			_, err = opts.Writer.Write(buf.Bytes())
+
+			return errors.New(err)
+		}
+
+		snapshotName := name
+
+		if snapshotName == "" {
+			if snapshotName, err = snapshot.Latest(ctx, l, store); err != nil {
+				return err
+			}
+
+			if snapshotName == "" {
+				if opts.RunAll {
+					l.Debugf("No state snapshots found, skipping unit %s", opts.WorkingDir)
+
+					return nil
+				}
+
+				return errors.Errorf("no state snapshots found for unit %s", opts.OriginalTerragruntConfigPath)
+			}
+		}
+
+		prompt := fmt.Sprintf("The %s backend state of unit %s will be overwritten with snapshot %s. Do you want to continue?", remoteState.BackendName, opts.OriginalTerragruntConfigPath, snapshotName)
+		if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil || !yes {
+			return err
+		}
+
+		// Read the snapshot before snapshotting the current state, since the retention may expire it.
+		data, err := store.Read(ctx, l, snapshotName)
+		if err != nil {
+			return err
+		}
+
+		if err := snapshot.Take(ctx, l, opts, remoteState, RestoreCommandName); err != nil {
+			return err
+		}
+
+		l.Infof("Restoring state snapshot %s", snapshotName)
+
+		return snapshot.Restore(ctx, l, opts, remoteState, data)
+	})
+}
+
+// RunWithRemoteState prepares the unit the same way `run` does, including downloading the source, assuming IAM roles
+// and initializing the backend, and then calls `fn` with the remote state of the unit. Units without
+// a `remote_state` block are skipped.
+func RunWithRemoteState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, stateCmd string, fn ActionFunc) error {
+	opts = opts.Clone()
+	opts.TerraformCommand = tf.CommandNameState
+	opts.TerraformCliArgs = cli.Args{tf.CommandNameState, stateCmd}
+
+	target := run.NewTarget(run.TargetPointInitCommand, func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, cfg *config.TerragruntConfig) error {
+		if cfg.RemoteState == nil {
+			l.Debugf("Did not find remote `remote_state` block in the config, skipping unit %s", opts.OriginalTerragruntConfigPath)
+
+			return nil
+		}
+
+		return fn(ctx, l, opts, cfg.RemoteState)
+	})
+
+	return run.RunWithTarget(ctx, l, opts, report.NewReport(), target)
+}
+
+// unitStateFilePath returns the path of the unit state file in the given directory.
+func unitStateFilePath(opts *options.TerragruntOptions, dir string) string {
+	return filepath.Join(dir, unitRelPath(opts), DefaultStateFileName)
+}
+
+// unitRelPath returns the path of the unit relative to the root working directory.
+func unitRelPath(opts *options.TerragruntOptions) string {
+	relPath, err := filepath.Rel(opts.RootWorkingDir, opts.WorkingDir)
+	if err != nil {
+		return opts.WorkingDir
+	}
+
+	return filepath.ToSlash(relPath)
+}
@@ -0,0 +1,70 @@
+package verify
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "verify"
+
+	FormatFlagName = "format"
+
+	JSONFlagName  = "json"
+	JSONFlagAlias = "j"
+
+	FixFlagName = "fix"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format for verify results. Valid values: text, json.",
+			DefaultText: FormatText,
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Aliases:     []string{JSONFlagAlias},
+			Destination: &opts.JSON,
+			Usage:       "Output in JSON format (equivalent to --format=json).",
+		}),
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        FixFlagName,
+			EnvVars:     tgPrefix.EnvVars(FixFlagName),
+			Destination: &opts.Fix,
+			Usage:       "Reconcile the drifted backend resources with the remote_state config.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Verify that the backend infrastructure of all discovered units matches their remote_state config.",
+		Flags: NewFlags(cmdOpts, nil),
+		Before: func(ctx *cli.Context) error {
+			if cmdOpts.JSON {
+				cmdOpts.Format = FormatJSON
+			}
+
+			if err := cmdOpts.Validate(); err != nil {
+				return cli.NewExitError(err, cli.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx *cli.Context) error {
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
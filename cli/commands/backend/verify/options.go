@@ -0,0 +1,44 @@
+package verify
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	// FormatText outputs the verification results in text format.
+	FormatText = "text"
+
+	// FormatJSON outputs the verification results in JSON format.
+	FormatJSON = "json"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+
+	// JSON determines if the output should be in JSON format.
+	// Alias for --format=json.
+	JSON bool
+
+	// Fix determines if the drifted resources should be reconciled with the config.
+	Fix bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Format:            FormatText,
+	}
+}
+
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+		return nil
+	default:
+		return errors.New("invalid format: " + o.Format)
+	}
+}
@@ -0,0 +1,187 @@
+// Package verify provides the ability to check the backend infrastructure of units for drift from their `remote_state` config.
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// UnitResult is the verification result of a single unit.
+type UnitResult struct {
+	Path    string         `json:"path"`
+	Backend string         `json:"backend"`
+	Error   string         `json:"error,omitempty"`
+	Drifts  backend.Drifts `json:"drifts"`
+}
+
+type UnitResults []*UnitResult
+
+// Run runs the verify command.
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	cfgs, err := discovery.NewDiscovery(opts.WorkingDir).Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	var results UnitResults
+
+	for _, cfg := range cfgs.Filter(discovery.ConfigTypeUnit).Sort() {
+		result, err := verifyUnit(ctx, l, opts, cfg.Path)
+		if err != nil {
+			return err
+		}
+
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+
+	switch opts.Format {
+	case FormatJSON:
+		err = outputJSON(opts, results)
+	default:
+		err = outputText(opts, results)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return results.Err()
+}
+
+// verifyUnit verifies the backend of the unit in the given directory. Returns nil if the unit has no `remote_state` block.
+func verifyUnit(ctx context.Context, l log.Logger, opts *Options, unitDir string) (*UnitResult, error) {
+	l, unitOpts, err := opts.CloneWithConfigPath(l, filepath.Join(unitDir, config.DefaultTerragruntConfigPath))
+	if err != nil {
+		return nil, err
+	}
+
+	relPath, err := filepath.Rel(opts.WorkingDir, unitDir)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	result := &UnitResult{Path: filepath.ToSlash(relPath)}
+
+	remoteState, err := config.ParseRemoteState(ctx, l, unitOpts)
+	if err != nil {
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	if remoteState == nil {
+		l.Debugf("Did not find remote `remote_state` block in the config, skipping unit %s", unitDir)
+
+		return nil, nil
+	}
+
+	result.Backend = remoteState.BackendName
+
+	drifts, err := remoteState.Verify(ctx, l, unitOpts)
+	if err != nil {
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	result.Drifts = drifts
+
+	if !opts.Fix || len(drifts) == 0 {
+		return result, nil
+	}
+
+	l.Infof("Fixing %d backend drift(s) of unit %s", len(drifts), result.Path)
+
+	if err := remoteState.Bootstrap(ctx, l, unitOpts); err != nil {
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	remaining, err := remoteState.Verify(ctx, l, unitOpts)
+	if err != nil {
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	drifts.MarkFixed(remaining)
+
+	return result, nil
+}
+
+// Err returns an error if any unit failed to be verified or has unfixed drifts.
+func (results UnitResults) Err() error {
+	var failed, drifted int
+
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		} else if len(result.Drifts.Unfixed()) > 0 {
+			drifted++
+		}
+	}
+
+	if failed == 0 && drifted == 0 {
+		return nil
+	}
+
+	return errors.Errorf("backend verification failed: %d unit(s) with drift, %d unit(s) with errors", drifted, failed)
+}
+
+func outputJSON(opts *Options, results UnitResults) error {
+	if results == nil {
+		results = UnitResults{}
+	}
+
+	jsonBytes, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if _, err := opts.Writer.Write(append(jsonBytes, '\n')); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+func outputText(opts *Options, results UnitResults) error {
+	var sb strings.Builder
+
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(&sb, "%s (%s): error: %s\n", result.Path, result.Backend, result.Error)
+		case len(result.Drifts) == 0:
+			fmt.Fprintf(&sb, "%s (%s): no drift\n", result.Path, result.Backend)
+		default:
+			fmt.Fprintf(&sb, "%s (%s):\n", result.Path, result.Backend)
+
+			for _, drift := range result.Drifts {
+				if drift.Fixed {
+					fmt.Fprintf(&sb, "  - %s (fixed)\n", drift)
+				} else {
+					fmt.Fprintf(&sb, "  - %s\n", drift)
+				}
+			}
+		}
+	}
+
+	if _, err := opts.Writer.Write([]byte(sb.String())); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
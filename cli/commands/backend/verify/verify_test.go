@@ -0,0 +1,93 @@
+package verify_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/verify"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const httpRemoteStateConfig = `
+remote_state {
+  backend = "http"
+  config = {
+    address = "%s"
+  }
+}
+`
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	availableServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer availableServer.Close()
+
+	unavailableServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer unavailableServer.Close()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		"available/terragrunt.hcl":   fmt.Sprintf(httpRemoteStateConfig, availableServer.URL),
+		"unavailable/terragrunt.hcl": fmt.Sprintf(httpRemoteStateConfig, unavailableServer.URL),
+		"local/terragrunt.hcl":       "",
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(path)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, path), []byte(content), 0644))
+	}
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = tmpDir
+
+	var output bytes.Buffer
+
+	opts := verify.NewOptions(tgOpts)
+	opts.Format = verify.FormatJSON
+	opts.Writer = &output
+
+	err = verify.Run(t.Context(), logger.CreateLogger(), opts)
+	require.Error(t, err)
+
+	var results verify.UnitResults
+
+	require.NoError(t, json.Unmarshal(output.Bytes(), &results))
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "available", results[0].Path)
+	assert.Equal(t, "http", results[0].Backend)
+	assert.Empty(t, results[0].Drifts)
+
+	assert.Equal(t, "unavailable", results[1].Path)
+	require.Len(t, results[1].Drifts, 1)
+	assert.Equal(t, "availability", results[1].Drifts[0].Setting)
+	assert.Equal(t, "unavailable", results[1].Drifts[0].Actual)
+	assert.False(t, results[1].Drifts[0].Fixed)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	opts := verify.NewOptions(options.NewTerragruntOptions())
+	require.NoError(t, opts.Validate())
+
+	opts.Format = "yaml"
+	require.Error(t, opts.Validate())
+}
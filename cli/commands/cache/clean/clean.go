@@ -0,0 +1,31 @@
+package clean
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/parsecache"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Run removes the parse cache in the directory set by `--parse-cache-dir`, or in the default directory. The cache is
+// removed even if `--parse-cache` is not set.
+func Run(_ context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	dir := opts.ParseCacheDir
+	if dir == "" {
+		defaultDir, err := parsecache.DefaultDir()
+		if err != nil {
+			return err
+		}
+
+		dir = defaultDir
+	}
+
+	if err := parsecache.New(dir).Clean(); err != nil {
+		return err
+	}
+
+	l.Infof("Removed the parse cache in %s", dir)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+// Package clean implements the terragrunt cache clean command, which removes the on-disk parse cache.
+package clean
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "clean"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:      CommandName,
+		Usage:     "Remove all the entries of the parse cache.",
+		UsageText: "terragrunt cache clean",
+		Action: func(ctx *cli.Context) error {
+			return Run(ctx, l, opts)
+		},
+	}
+}
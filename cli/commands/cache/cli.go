@@ -0,0 +1,24 @@
+// Package cache implements the cache command to manage the on-disk caches of Terragrunt.
+package cache
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/cache/clean"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "cache"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Manage the on-disk caches of Terragrunt.",
+		Subcommands: cli.Commands{
+			clean.NewCommand(l, opts),
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
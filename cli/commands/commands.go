@@ -10,12 +10,14 @@ import (
 
 	"github.com/gruntwork-io/go-commons/env"
 	"github.com/gruntwork-io/terragrunt/cli/commands/backend"
+	"github.com/gruntwork-io/terragrunt/cli/commands/cache"
 	"github.com/gruntwork-io/terragrunt/cli/commands/dag"
 	"github.com/gruntwork-io/terragrunt/cli/commands/find"
 	"github.com/gruntwork-io/terragrunt/cli/commands/hcl"
 	"github.com/gruntwork-io/terragrunt/cli/commands/info"
 	"github.com/gruntwork-io/terragrunt/cli/commands/list"
 	"github.com/gruntwork-io/terragrunt/cli/commands/render"
+	"github.com/gruntwork-io/terragrunt/cli/commands/report"
 	"github.com/gruntwork-io/terragrunt/cli/commands/stack"
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -96,6 +98,8 @@ func New(l log.Logger, opts *options.TerragruntOptions) cli.Commands {
 		hcl.NewCommand(l, opts),                // hcl
 		info.NewCommand(l, opts),               // info
 		dag.NewCommand(l, opts),                // dag
+		cache.NewCommand(l, opts),              // cache
+		report.NewCommand(l, opts),             // report
 		render.NewCommand(l, opts),             // render
 		helpCmd.NewCommand(l, opts),            // help (hidden)
 		versionCmd.NewCommand(opts),            // version (hidden)
@@ -294,6 +298,12 @@ func initialSetup(cliCtx *cli.Context, l log.Logger, opts *options.TerragruntOpt
 		opts.ExcludeByDefault = true
 	}
 
+	if !opts.ExcludeByDefault && opts.ChangedSince != "" {
+		l.Debugf("Changed since set. Excluding by default.")
+
+		opts.ExcludeByDefault = true
+	}
+
 	if !opts.ExcludeByDefault && opts.StrictInclude {
 		l.Debugf("Strict include set. Excluding by default.")
 
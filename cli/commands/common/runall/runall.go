@@ -48,6 +48,18 @@ func Run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) err
 		}
 	}
 
+	if opts.Watch {
+		return Watch(ctx, l, opts)
+	}
+
+	_, err := runStack(ctx, l, opts)
+
+	return err
+}
+
+// runStack finds the stack of units in the working directory and runs the command on it. It returns the stack, unless
+// it could not be found.
+func runStack(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (configstack.Stack, error) {
 	stackOpts := []configstack.Option{}
 
 	if opts.Experiments.Evaluate(experiment.Report) {
@@ -82,10 +94,10 @@ func Run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) err
 
 	stack, err := configstack.FindStackInSubfolders(ctx, l, opts, stackOpts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return RunAllOnStack(ctx, l, opts, stack)
+	return stack, RunAllOnStack(ctx, l, opts, stack)
 }
 
 func RunAllOnStack(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, stack configstack.Stack) error {
@@ -1,13 +1,22 @@
 package runall_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/cli/commands/common/runall"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -28,3 +37,104 @@ func TestMissingRunAllArguments(t *testing.T) {
 	fmt.Println(err, errors.Unwrap(err))
 	assert.True(t, ok)
 }
+
+func TestWatchRunsDependentsWithNewOutputs(t *testing.T) {
+	t.Parallel()
+
+	rootDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	depDir, appDir := filepath.Join(rootDir, "dep"), filepath.Join(rootDir, "app")
+
+	for path, contents := range map[string]string{
+		filepath.Join(depDir, config.DefaultTerragruntConfigPath): ``,
+		filepath.Join(depDir, "main.tf"):                          ``,
+		filepath.Join(depDir, "value.txt"):                        `one`,
+		filepath.Join(appDir, "main.tf"):                          ``,
+		filepath.Join(appDir, config.DefaultTerragruntConfigPath): `
+dependency "dep" {
+  config_path = "../dep"
+}
+
+inputs = {
+  value = dependency.dep.outputs.value
+}
+`,
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+	require.NoError(t, err)
+
+	opts.WorkingDir = rootDir
+	opts.TerraformCommand = tf.CommandNamePlan
+	opts.TerraformCliArgs = []string{tf.CommandNamePlan}
+	opts.NonInteractive = true
+
+	values := make(chan string, 10)
+
+	// The outputs of the dependency are the contents of its `value.txt` file, and the run of the dependent reports the
+	// value of its input.
+	opts.RunTerragrunt = func(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, _ *report.Report) error {
+		unitDir := filepath.Dir(opts.TerragruntConfigPath)
+
+		if opts.TerraformCommand == tf.CommandNameOutput {
+			value, err := os.ReadFile(filepath.Join(unitDir, "value.txt"))
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(opts.Writer, `{"value": {"type": "string", "value": %q}}`, strings.TrimSpace(string(value)))
+
+			return err
+		}
+
+		if unitDir != appDir {
+			return nil
+		}
+
+		cfg, err := config.ParseConfigFile(config.NewParsingContext(ctx, l, opts), l, opts.TerragruntConfigPath, nil)
+		if err != nil {
+			return err
+		}
+
+		values <- fmt.Sprint(cfg.Inputs["value"])
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(config.WithConfigValues(t.Context()))
+	done := make(chan error, 1)
+
+	go func() {
+		done <- runall.Watch(ctx, logger.CreateLogger(), opts)
+	}()
+
+	select {
+	case value := <-values:
+		assert.Equal(t, "one", value)
+	case <-time.After(time.Minute):
+		require.FailNow(t, "the dependent did not run")
+	}
+
+	// The watcher starts after the first run, so the change is written again, with other trailing whitespace, until
+	// the dependent runs again.
+	for attempt := 0; ; attempt++ {
+		require.Less(t, attempt, 10, "the dependent did not run again")
+		require.NoError(t, os.WriteFile(filepath.Join(depDir, "value.txt"), []byte("two"+strings.Repeat("\n", attempt)), 0644))
+
+		select {
+		case value := <-values:
+			assert.Equal(t, "two", value)
+		case <-time.After(5 * time.Second):
+			continue
+		}
+
+		break
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
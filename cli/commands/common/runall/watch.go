@@ -0,0 +1,69 @@
+package runall
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/watch"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Watch runs the command on the stack of units in the working directory, then watches the directories of the units,
+// the files they include or read, and their local module sources. On each change, it runs the command again on the
+// units affected by the change, and their dependents, until the context is done.
+//
+// Each run parses the configurations of its units again, and starts with empty caches of the outputs of dependencies
+// and of the results of `run_cmd`, so the dependents of a changed unit see its new outputs. Only the partial parses
+// stored by `--parse-cache` are reused between runs, for the files that did not change.
+func Watch(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	// Each run gets its own copy of the options, as running a stack updates them.
+	runOpts := opts.Clone()
+
+	stack, err := runStack(ctx, l, runOpts)
+	if stack == nil {
+		return err
+	}
+
+	if err != nil {
+		// Keep watching, so the error can be fixed.
+		l.Errorf("Run failed: %v", err)
+	}
+
+	watcher := watch.New(stack.Modules().WatchedPaths(runOpts))
+
+	for {
+		l.Infof("Watching %d path(s) for changes", len(watcher.Paths()))
+
+		changed, err := watcher.Wait(ctx)
+		if err != nil {
+			// The watch only stops when the context is done, e.g. on an interrupt, which is not an error.
+			return nil //nolint:nilerr
+		}
+
+		l.Infof("Detected changes in %s", strings.Join(changed, ", "))
+
+		// Drop the outputs, configurations and `run_cmd` results cached by the previous run, as they may be stale.
+		config.ClearOutputCache()
+
+		runCtx := config.WithConfigValues(ctx)
+
+		runOpts = opts.Clone()
+		runOpts.ChangedSince = ""
+		runOpts.ChangedFiles = changed
+		runOpts.ExcludeByDefault = true
+		runOpts.IncludeChangedDependents = true
+
+		stack, err := runStack(runCtx, l, runOpts)
+		if stack != nil {
+			// Watch the files the units started to depend on too, like a newly included file.
+			watcher.SetPaths(append(watcher.Paths(), stack.Modules().WatchedPaths(runOpts)...))
+		}
+
+		if err != nil {
+			// Keep watching, so the error can be fixed.
+			l.Errorf("Run failed: %v", err)
+		}
+	}
+}
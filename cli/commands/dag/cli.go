@@ -3,6 +3,7 @@
 package dag
 
 import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/dag/cycles"
 	"github.com/gruntwork-io/terragrunt/cli/commands/dag/graph"
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
@@ -22,6 +23,7 @@ func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
 		Usage: "Interact with the Directed Acyclic Graph (DAG).",
 		Subcommands: cli.Commands{
 			graph.NewCommand(l, opts, prefix),
+			cycles.NewCommand(l, opts, prefix),
 		},
 		Action: cli.ShowCommandHelp,
 	}
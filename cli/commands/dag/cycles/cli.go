@@ -0,0 +1,41 @@
+package cycles
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "cycles"
+
+	JSONFlagName = "json"
+)
+
+func NewFlags(opts *Options) cli.Flags {
+	tgPrefix := flags.Prefix{flags.TgPrefix}
+
+	return cli.Flags{
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        JSONFlagName,
+			EnvVars:     tgPrefix.EnvVars(JSONFlagName),
+			Destination: &opts.JSON,
+			Usage:       "Output the dependency cycles as JSON diagnostics.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions, _ flags.Prefix) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:      CommandName,
+		Usage:     "Explain the dependency cycles of the Directed Acyclic Graph (DAG).",
+		UsageText: "terragrunt dag cycles",
+		Flags:     NewFlags(cmdOpts),
+		Action: func(ctx *cli.Context) error {
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
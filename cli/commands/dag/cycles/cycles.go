@@ -0,0 +1,51 @@
+// Package cycles implements the terragrunt dag cycles command, which explains every dependency cycle of the
+// Terragrunt dependency graph with the units involved and the blocks creating each dependency.
+package cycles
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/view"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Run discovers the configurations in the working directory and writes a diagnostic for each dependency of each
+// cycle. It returns an error if there is any cycle.
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	d := discovery.NewDiscovery(opts.WorkingDir).
+		WithSuppressParseErrors().
+		WithDiscoverDependencies().
+		WithKeepCycles()
+
+	cfgs, err := d.Discover(ctx, l, opts.TerragruntOptions)
+	if err != nil {
+		return err
+	}
+
+	cycles := cfgs.Cycles()
+	if len(cycles) == 0 {
+		l.Info("No dependency cycles found")
+
+		return nil
+	}
+
+	var diags diagnostic.Diagnostics
+
+	for _, cycle := range cycles {
+		diags = append(diags, cycle.CycleDiagnostics()...)
+	}
+
+	render := view.NewHumanRender(l.Formatter().DisabledColors())
+	if opts.JSON {
+		render = view.NewJSONRender()
+	}
+
+	if err := view.NewWriter(opts.Writer, render).Diagnostics(diags); err != nil {
+		return err
+	}
+
+	return errors.Errorf("found %d dependency cycle(s)", len(cycles))
+}
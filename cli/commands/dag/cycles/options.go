@@ -0,0 +1,16 @@
+package cycles
+
+import "github.com/gruntwork-io/terragrunt/options"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// JSON determines if the diagnostics should be in JSON format.
+	JSON bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+	}
+}
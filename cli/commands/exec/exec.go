@@ -38,7 +38,8 @@ func runTargetCommand(cmdOpts *Options, args cli.Args) run.TargetCallbackType {
 			dir = opts.RootWorkingDir
 		}
 
-		return run.RunActionWithHooks(ctx, l, command, opts, cfg, func(ctx context.Context) error {
+		// The command is not a run of the unit, so its hooks are not recorded in a report.
+		return run.RunActionWithHooks(ctx, l, command, opts, cfg, nil, func(ctx context.Context) error {
 			_, err := shell.RunCommandWithOutput(ctx, l, opts, dir, false, false, command, args...)
 			if err != nil {
 				return errors.Errorf("failed to run command in directory %s: %w", dir, err)
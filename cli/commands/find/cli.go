@@ -26,6 +26,8 @@ const (
 	Exclude        = "exclude"
 	Include        = "include"
 
+	FilterFlagName = "filter"
+
 	QueueConstructAsFlagName  = "queue-construct-as"
 	QueueConstructAsFlagAlias = "as"
 )
@@ -84,6 +86,12 @@ func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
 			Destination: &opts.External,
 			Usage:       "Discover external dependencies from initial results, and add them to top-level results.",
 		}),
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        FilterFlagName,
+			EnvVars:     tgPrefix.EnvVars(FilterFlagName),
+			Destination: &opts.Filters,
+			Usage:       "Only output the configurations matching the filter expression. Can be specified multiple times to output the configurations matching any of them.",
+		}),
 		flags.NewFlag(&cli.GenericFlag[string]{
 			Name:        QueueConstructAsFlagName,
 			EnvVars:     tgPrefix.EnvVars(QueueConstructAsFlagName),
@@ -12,6 +12,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/discovery"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
 	"github.com/gruntwork-io/terragrunt/internal/os/stdout"
 	"github.com/gruntwork-io/terragrunt/internal/queue"
 	"github.com/mgutz/ansi"
@@ -19,6 +20,11 @@ import (
 
 // Run runs the find command.
 func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	filters, err := filter.ParseAll(opts.Filters)
+	if err != nil {
+		return errors.New(err)
+	}
+
 	d := discovery.
 		NewDiscovery(opts.WorkingDir).
 		WithSuppressParseErrors()
@@ -31,6 +37,22 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 		d = d.WithDiscoverDependencies()
 	}
 
+	if filters.RequiresDependencies() {
+		d = d.WithDiscoverDependencies()
+	}
+
+	if filters.RequiresParse() {
+		d = d.WithParseInclude()
+	}
+
+	if filters.Uses(filter.AttributeExclude) {
+		d = d.WithParseExclude()
+	}
+
+	if filters.Uses(filter.AttributeSource) {
+		d = d.WithParseTerraformSource()
+	}
+
 	if opts.External {
 		d = d.WithDiscoverExternalDependencies()
 	}
@@ -54,11 +76,12 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 
 	var discoverErr error
 
-	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "find_discover", map[string]any{
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "find_discover", map[string]any{
 		"working_dir":  opts.WorkingDir,
 		"hidden":       opts.Hidden,
 		"dependencies": opts.Dependencies,
 		"external":     opts.External,
+		"filters":      len(filters),
 		"mode":         opts.Mode,
 		"exclude":      opts.Exclude,
 	}, func(ctx context.Context) error {
@@ -96,6 +119,8 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 		return errors.New("invalid mode: " + opts.Mode)
 	}
 
+	cfgs = filters.Select(opts.TerragruntOptions, cfgs)
+
 	var foundCfgs FoundConfigs
 
 	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "find_discovered_to_found", map[string]any{
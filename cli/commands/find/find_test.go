@@ -26,6 +26,7 @@ func TestRun(t *testing.T) {
 		format        string
 		mode          string
 		expectedPaths []string
+		filters       []string
 		hidden        bool
 		dependencies  bool
 		external      bool
@@ -380,6 +381,45 @@ dependency "B" {
 				assert.Equal(t, []string{"B"}, configs[2].Dependencies, "C should depend on B")
 			},
 		},
+		{
+			name: "filter expressions",
+			setup: func(t *testing.T) string {
+				t.Helper()
+
+				tmpDir := t.TempDir()
+
+				testFiles := map[string]string{
+					"vpc/terragrunt.hcl":         "terraform {\n  source = \"../modules//vpc\"\n}\n",
+					"db/terragrunt.hcl":          "dependency \"vpc\" {\n  config_path = \"../vpc\"\n}\n",
+					"app/terragrunt.hcl":         "dependencies {\n  paths = [\"../db\"]\n}\n",
+					"dns/terragrunt.hcl":         "exclude {\n  if      = true\n  actions = [\"destroy\"]\n}\n",
+					"stack/terragrunt.stack.hcl": "",
+				}
+
+				for path, content := range testFiles {
+					require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpDir, path)), 0755))
+					require.NoError(t, os.WriteFile(filepath.Join(tmpDir, path), []byte(content), 0644))
+				}
+
+				return tmpDir
+			},
+			filters:       []string{"type=unit & dependency-of=app", "exclude=destroy | source=*//vpc"},
+			expectedPaths: []string{"db", "dns", "vpc"},
+			format:        "text",
+			mode:          "normal",
+			validate: func(t *testing.T, output string, expectedPaths []string) {
+				t.Helper()
+
+				lines := strings.Split(strings.TrimSpace(output), "\n")
+
+				var paths []string
+				for _, line := range lines {
+					paths = append(paths, filepath.ToSlash(strings.TrimSpace(line)))
+				}
+
+				assert.Equal(t, expectedPaths, paths)
+			},
+		},
 		{
 			name: "invalid format",
 			setup: func(t *testing.T) string {
@@ -428,6 +468,7 @@ dependency "B" {
 			opts.Mode = tt.mode
 			opts.Dependencies = tt.dependencies
 			opts.External = tt.external
+			opts.Filters = tt.filters
 
 			// Create a pipe to capture output
 			r, w, err := os.Pipe()
@@ -2,6 +2,7 @@ package find
 
 import (
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
 	"github.com/gruntwork-io/terragrunt/options"
 )
 
@@ -37,6 +38,9 @@ type Options struct {
 	// QueueConstructAs constructs the queue as if a particular command was run.
 	QueueConstructAs string
 
+	// Filters are the filter expressions selecting the configurations in the output.
+	Filters []string
+
 	// JSON determines if the output should be in JSON format.
 	// Alias for --format=json.
 	JSON bool
@@ -80,6 +84,10 @@ func (o *Options) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if _, err := filter.ParseAll(o.Filters); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return errors.New(errors.Join(errs...))
 	}
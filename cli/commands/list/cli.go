@@ -27,6 +27,8 @@ const (
 
 	DAGFlagName = "dag"
 
+	FilterFlagName = "filter"
+
 	QueueConstructAsFlagName  = "queue-construct-as"
 	QueueConstructAsFlagAlias = "as"
 )
@@ -80,6 +82,12 @@ func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
 			Destination: &opts.DAG,
 			Usage:       "Use DAG mode to sort and group output.",
 		}),
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        FilterFlagName,
+			EnvVars:     tgPrefix.EnvVars(FilterFlagName),
+			Destination: &opts.Filters,
+			Usage:       "Only output the configurations matching the filter expression. Can be specified multiple times to output the configurations matching any of them.",
+		}),
 		flags.NewFlag(&cli.GenericFlag[string]{
 			Name:        QueueConstructAsFlagName,
 			EnvVars:     tgPrefix.EnvVars(QueueConstructAsFlagName),
@@ -14,6 +14,7 @@ import (
 	"github.com/charmbracelet/x/term"
 	"github.com/gruntwork-io/terragrunt/internal/discovery"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
 	"github.com/gruntwork-io/terragrunt/internal/os/stdout"
 	"github.com/gruntwork-io/terragrunt/internal/queue"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -22,6 +23,11 @@ import (
 
 // Run runs the list command.
 func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	filters, err := filter.ParseAll(opts.Filters)
+	if err != nil {
+		return errors.New(err)
+	}
+
 	d := discovery.
 		NewDiscovery(opts.WorkingDir).
 		WithSuppressParseErrors()
@@ -34,6 +40,22 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 		d = d.WithDiscoverDependencies()
 	}
 
+	if filters.RequiresDependencies() {
+		d = d.WithDiscoverDependencies()
+	}
+
+	if filters.RequiresParse() {
+		d = d.WithParseInclude()
+	}
+
+	if filters.Uses(filter.AttributeExclude) {
+		d = d.WithParseExclude()
+	}
+
+	if filters.Uses(filter.AttributeSource) {
+		d = d.WithParseTerraformSource()
+	}
+
 	if opts.External {
 		d = d.WithDiscoverExternalDependencies()
 	}
@@ -51,11 +73,12 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 	var discoverErr error
 
 	// Wrap discovery with telemetry
-	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "list_discover", map[string]any{
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "list_discover", map[string]any{
 		"working_dir":  opts.WorkingDir,
 		"hidden":       opts.Hidden,
 		"dependencies": shouldDiscoverDependencies(opts),
 		"external":     opts.External,
+		"filters":      len(filters),
 	}, func(ctx context.Context) error {
 		cfgs, discoverErr = d.Discover(ctx, l, opts.TerragruntOptions)
 		return discoverErr
@@ -92,6 +115,8 @@ func Run(ctx context.Context, l log.Logger, opts *Options) error {
 		return errors.New("invalid mode: " + opts.Mode)
 	}
 
+	cfgs = filters.Select(opts.TerragruntOptions, cfgs)
+
 	var listedCfgs ListedConfigs
 
 	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "list_discovered_to_listed", map[string]any{
@@ -2,6 +2,7 @@ package list
 
 import (
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
 	"github.com/gruntwork-io/terragrunt/options"
 )
 
@@ -40,6 +41,9 @@ type Options struct {
 	// QueueConstructAs constructs the queue as if a particular command was run.
 	QueueConstructAs string
 
+	// Filters are the filter expressions selecting the configurations in the output.
+	Filters []string
+
 	// Hidden determines whether to detect hidden directories.
 	Hidden bool
 
@@ -79,6 +83,10 @@ func (o *Options) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if _, err := filter.ParseAll(o.Filters); err != nil {
+		errs = append(errs, err)
+	}
+
 	if len(errs) > 0 {
 		return errors.New(errors.Join(errs...))
 	}
@@ -0,0 +1,24 @@
+// Package report implements the report command to work with the reports of runs.
+package report
+
+import (
+	"github.com/gruntwork-io/terragrunt/cli/commands/report/compare"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "report"
+)
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	return &cli.Command{
+		Name:  CommandName,
+		Usage: "Work with the reports of runs.",
+		Subcommands: cli.Commands{
+			compare.NewCommand(l, opts),
+		},
+		Action: cli.ShowCommandHelp,
+	}
+}
@@ -0,0 +1,98 @@
+package compare
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	CommandName = "compare"
+
+	FormatFlagName                 = "format"
+	DurationThresholdFlagName      = "duration-threshold"
+	DurationMinIncreaseFlagName    = "duration-min-increase"
+	MaxNewFailuresFlagName         = "max-new-failures"
+	MaxDurationRegressionsFlagName = "max-duration-regressions"
+
+	usageText = "terragrunt report compare [options] <old-report> <new-report>"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) cli.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return cli.Flags{
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format of the comparison. Valid values: human, json, markdown.",
+			DefaultText: FormatHuman,
+		}),
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        DurationThresholdFlagName,
+			EnvVars:     tgPrefix.EnvVars(DurationThresholdFlagName),
+			Destination: &opts.DurationThreshold,
+			Usage:       "Minimum increase of the duration of a unit, in percent of its old duration, for the increase to be a regression.",
+		}),
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        DurationMinIncreaseFlagName,
+			EnvVars:     tgPrefix.EnvVars(DurationMinIncreaseFlagName),
+			Usage:       "Minimum increase of the duration of a unit for the increase to be a regression, e.g. 30s.",
+			DefaultText: DefaultDurationMinIncrease.String(),
+			Setter: func(value string) error {
+				increase, err := time.ParseDuration(value)
+				if err != nil || increase < 0 {
+					return fmt.Errorf("invalid duration min increase %q, expected a duration like 10s or 1m", value)
+				}
+
+				opts.DurationMinIncrease = increase
+
+				return nil
+			},
+		}),
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        MaxNewFailuresFlagName,
+			EnvVars:     tgPrefix.EnvVars(MaxNewFailuresFlagName),
+			Destination: &opts.MaxNewFailures,
+			Usage:       "Exit with an error if more units than this are newly failing. Set to -1 to never exit with an error.",
+		}),
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        MaxDurationRegressionsFlagName,
+			EnvVars:     tgPrefix.EnvVars(MaxDurationRegressionsFlagName),
+			Destination: &opts.MaxDurationRegressions,
+			Usage:       "Exit with an error if more units than this have a duration regression. Set to -1 to never exit with an error.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *cli.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &cli.Command{
+		Name:      CommandName,
+		Usage:     "Compare two JSON run reports, such as the reports of two nightly runs.",
+		UsageText: usageText,
+		Flags:     NewFlags(cmdOpts, nil),
+		Before: func(ctx *cli.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return cli.NewExitError(err, cli.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx *cli.Context) error {
+			oldPath, newPath := ctx.Args().First(), ctx.Args().Second()
+			if oldPath == "" || newPath == "" {
+				return errors.New(usageText)
+			}
+
+			return Run(ctx, l, cmdOpts, oldPath, newPath)
+		},
+	}
+}
@@ -0,0 +1,76 @@
+// Package compare implements the terragrunt report compare command, which compares two JSON run reports to find the
+// units added or removed, the units newly failing or newly fixed, and the units that became slower.
+package compare
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Run reads the old and the new reports, and writes their comparison in the format of the options. It returns an
+// error if the comparison exceeds any of the limits of the options.
+func Run(_ context.Context, l log.Logger, opts *Options, oldPath, newPath string) error {
+	oldRuns, err := report.ReadJSONFile(reportPath(opts, oldPath))
+	if err != nil {
+		return err
+	}
+
+	newRuns, err := report.ReadJSONFile(reportPath(opts, newPath))
+	if err != nil {
+		return err
+	}
+
+	comparison := report.Compare(oldRuns, newRuns, report.CompareOptions{
+		DurationThreshold:   float64(opts.DurationThreshold),
+		MinDurationIncrease: opts.DurationMinIncrease,
+	})
+
+	switch opts.Format {
+	case FormatJSON:
+		err = comparison.WriteJSON(opts.Writer)
+	case FormatMarkdown:
+		err = comparison.WriteMarkdown(opts.Writer)
+	default:
+		err = comparison.WriteHuman(opts.Writer, !l.Formatter().DisabledColors())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return checkLimits(opts, comparison)
+}
+
+// checkLimits returns an error if the comparison has more newly failing units or duration regressions than allowed,
+// which exits with the general error code.
+func checkLimits(opts *Options, comparison *report.Comparison) error {
+	var errs []error
+
+	if newFailures := len(comparison.NewlyFailing); opts.MaxNewFailures != NoLimit && newFailures > opts.MaxNewFailures {
+		errs = append(errs, errors.Errorf("found %d newly failing unit(s), more than the limit of %d", newFailures, opts.MaxNewFailures))
+	}
+
+	if regressions := len(comparison.DurationRegressions); opts.MaxDurationRegressions != NoLimit && regressions > opts.MaxDurationRegressions {
+		errs = append(errs, errors.Errorf("found %d duration regression(s), more than the limit of %d", regressions, opts.MaxDurationRegressions))
+	}
+
+	if len(errs) > 0 {
+		return cli.NewExitError(errors.Join(errs...), cli.ExitCodeGeneralError)
+	}
+
+	return nil
+}
+
+// reportPath returns the path of a report, relative to the working directory if it is not absolute.
+func reportPath(opts *Options, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(opts.WorkingDir, path)
+}
@@ -0,0 +1,86 @@
+package compare
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+)
+
+const (
+	// FormatHuman outputs the comparison in a human-readable format.
+	FormatHuman = "human"
+
+	// FormatJSON outputs the comparison in JSON format.
+	FormatJSON = "json"
+
+	// FormatMarkdown outputs the comparison in Markdown format.
+	FormatMarkdown = "markdown"
+
+	// DefaultDurationThreshold is the default minimum increase of a duration, in percent, to be a regression.
+	DefaultDurationThreshold = 20
+
+	// DefaultDurationMinIncrease is the default minimum increase of a duration to be a regression.
+	DefaultDurationMinIncrease = 10 * time.Second
+
+	// NoLimit is the value of the limits that never make the command exit with an error.
+	NoLimit = -1
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+
+	// DurationThreshold is the minimum increase of the duration of a unit, in percent, to be a regression.
+	DurationThreshold int
+
+	// DurationMinIncrease is the minimum increase of the duration of a unit to be a regression.
+	DurationMinIncrease time.Duration
+
+	// MaxNewFailures is the number of newly failing units above which the command exits with an error.
+	MaxNewFailures int
+
+	// MaxDurationRegressions is the number of duration regressions above which the command exits with an error.
+	MaxDurationRegressions int
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions:      opts,
+		Format:                 FormatHuman,
+		DurationThreshold:      DefaultDurationThreshold,
+		DurationMinIncrease:    DefaultDurationMinIncrease,
+		MaxNewFailures:         NoLimit,
+		MaxDurationRegressions: NoLimit,
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := []error{}
+
+	switch o.Format {
+	case FormatHuman, FormatJSON, FormatMarkdown:
+	default:
+		errs = append(errs, errors.New("invalid format: "+o.Format))
+	}
+
+	if o.DurationThreshold < 0 {
+		errs = append(errs, errors.Errorf("invalid duration threshold: %d", o.DurationThreshold))
+	}
+
+	if o.MaxNewFailures < NoLimit {
+		errs = append(errs, errors.Errorf("invalid max new failures: %d", o.MaxNewFailures))
+	}
+
+	if o.MaxDurationRegressions < NoLimit {
+		errs = append(errs, errors.Errorf("invalid max duration regressions: %d", o.MaxDurationRegressions))
+	}
+
+	if len(errs) > 0 {
+		return errors.New(errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -85,6 +85,11 @@ func Action(l log.Logger, opts *options.TerragruntOptions) cli.ActionFunc {
 			return err
 		}
 
+		// With `--all`, the stack is watched instead, and this action runs the command in each unit.
+		if opts.Watch && !opts.RunAll {
+			return Watch(ctx.Context, l, opts.OptionsFromContext(ctx))
+		}
+
 		r := report.NewReport().WithWorkingDir(opts.WorkingDir)
 
 		return Run(ctx.Context, l, opts.OptionsFromContext(ctx), r)
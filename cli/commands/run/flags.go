@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -35,6 +39,10 @@ const (
 	BackendRequireBootstrapFlagName = "backend-require-bootstrap"
 	DisableBucketUpdateFlagName     = "disable-bucket-update"
 
+	StateSnapshotDirFlagName          = "state-snapshot-dir"
+	StateSnapshotBucketPrefixFlagName = "state-snapshot-bucket-prefix"
+	StateSnapshotRetentionFlagName    = "state-snapshot-retention"
+
 	DisableCommandValidationFlagName   = "disable-command-validation"
 	AuthProviderCmdFlagName            = "auth-provider-cmd"
 	NoDestroyDependenciesCheckFlagName = "no-destroy-dependencies-check"
@@ -64,6 +72,21 @@ const (
 	QueueStrictIncludeFlagName       = "queue-strict-include"
 	QueueIncludeUnitsReadingFlagName = "queue-include-units-reading"
 
+	QueueIncludeChangedSinceFlagName      = "queue-include-changed-since"
+	QueueIncludeChangedDependentsFlagName = "queue-include-changed-dependents"
+
+	QueueFilterFlagName = "queue-filter"
+
+	QueueShardFlagName       = "queue-shard"
+	QueueShardReportFlagName = "queue-shard-report"
+
+	QueueOrderFlagName       = "queue-order"
+	QueueOrderReportFlagName = "queue-order-report"
+
+	QueueTimeoutFlagName = "queue-timeout"
+
+	WatchFlagName = "watch"
+
 	// Terragrunt Provider Cache related flags.
 
 	ProviderCacheFlagName              = "provider-cache"
@@ -86,6 +109,7 @@ const (
 	ReportFileFlagName     = "report-file"
 	ReportFormatFlagName   = "report-format"
 	ReportSchemaFlagName   = "report-schema-file"
+	ResumeFromFlagName     = "resume-from"
 
 	// `--all` related flags.
 
@@ -392,6 +416,99 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames("queue-include-units-reading"), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        QueueIncludeChangedSinceFlagName,
+			EnvVars:     tgPrefix.EnvVars(QueueIncludeChangedSinceFlagName),
+			Destination: &opts.ChangedSince,
+			Usage:       "If flag is set, 'run --all' will only run the command against Terragrunt units affected by the files changed between the specified git ref and the working tree.",
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        QueueIncludeChangedDependentsFlagName,
+			EnvVars:     tgPrefix.EnvVars(QueueIncludeChangedDependentsFlagName),
+			Destination: &opts.IncludeChangedDependents,
+			Usage:       "When used with '--queue-include-changed-since', also include the units that depend on the changed units.",
+		}),
+
+		flags.NewFlag(&cli.SliceFlag[string]{
+			Name:        QueueFilterFlagName,
+			EnvVars:     tgPrefix.EnvVars(QueueFilterFlagName),
+			Destination: &opts.QueueFilters,
+			Usage:       "If flag is set, 'run --all' will only run the command against Terragrunt units matching the filter expression. Can be specified multiple times to run the units matching any of them.",
+			Setter: func(value string) error {
+				_, err := filter.Parse(value)
+
+				return err
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    QueueShardFlagName,
+			EnvVars: tgPrefix.EnvVars(QueueShardFlagName),
+			Usage:   "Split the units of 'run --all' into shards, given as <index>/<count>, and only run the units of the given shard.",
+			Setter: func(value string) error {
+				if _, err := queue.ParseShard(value); err != nil {
+					return err
+				}
+
+				opts.QueueShard = value
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        QueueShardReportFlagName,
+			EnvVars:     tgPrefix.EnvVars(QueueShardReportFlagName),
+			Destination: &opts.QueueShardReport,
+			Usage:       "When used with '--queue-shard', balance the shards using the durations of the units in the given JSON report of a previous run.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    QueueOrderFlagName,
+			EnvVars: tgPrefix.EnvVars(QueueOrderFlagName),
+			Usage:   "The order in which 'run --all' starts the units that are ready to run: " + strings.Join(queue.OrderNames, ", ") + ". Defaults to alphabetical.",
+			Setter: func(value string) error {
+				if _, err := queue.NewOrder(value, nil); err != nil {
+					return err
+				}
+
+				opts.QueueOrder = value
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        QueueOrderReportFlagName,
+			EnvVars:     tgPrefix.EnvVars(QueueOrderReportFlagName),
+			Destination: &opts.QueueOrderReport,
+			Usage:       "When used with '--queue-order=critical-path', weight the critical path using the durations of the units in the given JSON report of a previous run.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:    QueueTimeoutFlagName,
+			EnvVars: tgPrefix.EnvVars(QueueTimeoutFlagName),
+			Usage:   "The maximum duration of 'run --all', e.g. 2h. Units still running once it expires are interrupted, and units that did not start are not run.",
+			Setter: func(value string) error {
+				timeout, err := time.ParseDuration(value)
+				if err != nil || timeout <= 0 {
+					return fmt.Errorf("invalid queue timeout %q, expected a positive duration like 2h or 90m", value)
+				}
+
+				opts.QueueTimeout = timeout
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        WatchFlagName,
+			EnvVars:     tgPrefix.EnvVars(WatchFlagName),
+			Destination: &opts.Watch,
+			Usage:       "Watch the files the units depend on, and run the command again on the units affected by each change, and their dependents.",
+		}),
+
 		flags.NewFlag(&cli.BoolFlag{
 			Name:        BackendBootstrapFlagName,
 			EnvVars:     tgPrefix.EnvVars(BackendBootstrapFlagName),
@@ -415,6 +532,27 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		},
 			flags.WithDeprecatedNames(terragruntPrefix.FlagNames("disable-bucket-update"), terragruntPrefixControl)),
 
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        StateSnapshotDirFlagName,
+			EnvVars:     tgPrefix.EnvVars(StateSnapshotDirFlagName),
+			Destination: &opts.StateSnapshotDir,
+			Usage:       "Snapshot the state of the unit to this local directory before apply, destroy, import and state-mutating commands.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        StateSnapshotBucketPrefixFlagName,
+			EnvVars:     tgPrefix.EnvVars(StateSnapshotBucketPrefixFlagName),
+			Destination: &opts.StateSnapshotBucketPrefix,
+			Usage:       "Snapshot the state of the unit under this prefix of the backend bucket before apply, destroy, import and state-mutating commands.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[int]{
+			Name:        StateSnapshotRetentionFlagName,
+			EnvVars:     tgPrefix.EnvVars(StateSnapshotRetentionFlagName),
+			Destination: &opts.StateSnapshotRetention,
+			Usage:       "Number of state snapshots to keep for each unit. Set to 0 to keep all of them.",
+		}),
+
 		flags.NewFlag(&cli.BoolFlag{
 			Name:        DisableCommandValidationFlagName,
 			EnvVars:     tgPrefix.EnvVars(DisableCommandValidationFlagName),
@@ -567,17 +705,22 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 
 				opts.ReportFile = value
 
-				ext := filepath.Ext(value)
-				if ext == "" {
-					ext = ".csv"
-				}
+				format := report.FormatCSV
 
-				if ext != ".csv" && ext != ".json" {
+				switch filepath.Ext(value) {
+				case "", ".csv":
+				case ".json":
+					format = report.FormatJSON
+				case ".xml":
+					format = report.FormatJUnit
+				case ".md":
+					format = report.FormatMarkdown
+				default:
 					return nil
 				}
 
 				if opts.ReportFormat == "" {
-					opts.ReportFormat = report.Format(ext[1:])
+					opts.ReportFormat = format
 				}
 
 				return nil
@@ -600,6 +743,8 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 				switch opts.ReportFormat {
 				case report.FormatCSV:
 				case report.FormatJSON:
+				case report.FormatJUnit:
+				case report.FormatMarkdown:
 				default:
 					return fmt.Errorf("unsupported report format: %s", value)
 				}
@@ -614,6 +759,13 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 			Usage:       `Path to generate report schema file in.`,
 			Destination: &opts.ReportSchemaFile,
 		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        ResumeFromFlagName,
+			EnvVars:     tgPrefix.EnvVars(ResumeFromFlagName),
+			Usage:       `Path to the JSON report of a previous run. Units that succeeded in that run, and did not change since, are skipped.`,
+			Destination: &opts.ResumeFrom,
+		}),
 	}
 
 	return flags.Sort()
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/cloner"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/shell"
@@ -23,7 +26,7 @@ const (
 	HookCtxHookNameEnvName = "TG_CTX_HOOK_NAME"
 )
 
-func processErrorHooks(ctx context.Context, l log.Logger, hooks []config.ErrorHook, terragruntOptions *options.TerragruntOptions, previousExecErrors *errors.MultiError) error {
+func processErrorHooks(ctx context.Context, l log.Logger, hooks []config.ErrorHook, terragruntOptions *options.TerragruntOptions, r *report.Report, previousExecErrors *errors.MultiError) error {
 	if len(hooks) == 0 || previousExecErrors.ErrorOrNil() == nil {
 		return nil
 	}
@@ -73,6 +76,7 @@ func processErrorHooks(ctx context.Context, l log.Logger, hooks []config.ErrorHo
 			actionParams := curHook.Execute[1:]
 			terragruntOptions = terragruntOptionsWithHookEnvs(terragruntOptions, curHook.Name)
 
+			started := time.Now()
 			_, possibleError := shell.RunCommandWithOutput(
 				ctx,
 				l,
@@ -82,6 +86,11 @@ func processErrorHooks(ctx context.Context, l log.Logger, hooks []config.ErrorHo
 				false,
 				actionToExecute, actionParams...,
 			)
+
+			if terragruntOptions.Experiments.Evaluate(experiment.Report) {
+				recordHook(l, terragruntOptions, r, report.HookTypeError, curHook.Name, time.Since(started), possibleError)
+			}
+
 			if possibleError != nil {
 				l.Errorf("Error running hook %s with message: %s", curHook.Name, possibleError.Error())
 				errorsOccured = multierror.Append(errorsOccured, possibleError)
@@ -95,9 +104,11 @@ func processErrorHooks(ctx context.Context, l log.Logger, hooks []config.ErrorHo
 func processHooks(
 	ctx context.Context,
 	l log.Logger,
+	hookType report.HookType,
 	hooks []config.Hook,
 	opts *options.TerragruntOptions,
 	cfg *config.TerragruntConfig,
+	r *report.Report,
 	previousExecErrors *errors.MultiError,
 ) error {
 	if len(hooks) == 0 {
@@ -116,12 +127,18 @@ func processHooks(
 
 		allPreviousErrors := previousExecErrors.Append(errorsOccured)
 		if shouldRunHook(curHook, opts, allPreviousErrors) {
+			started := time.Now()
 			err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "hook_"+curHook.Name, map[string]any{
 				"hook": curHook.Name,
 				"dir":  curHook.WorkingDir,
 			}, func(ctx context.Context) error {
 				return runHook(ctx, l, opts, cfg, curHook)
 			})
+
+			if opts.Experiments.Evaluate(experiment.Report) {
+				recordHook(l, opts, r, hookType, curHook.Name, time.Since(started), err)
+			}
+
 			if err != nil {
 				errorsOccured = multierror.Append(errorsOccured, err)
 			}
@@ -131,6 +148,37 @@ func processHooks(
 	return errorsOccured.ErrorOrNil()
 }
 
+// recordHook records the run of a hook in the run of the unit, if there is a report and it has one. The exit code of a
+// hook that failed without exiting is recorded as -1.
+func recordHook(l log.Logger, opts *options.TerragruntOptions, r *report.Report, hookType report.HookType, name string, duration time.Duration, err error) {
+	if r == nil {
+		return
+	}
+
+	run, runErr := reportRun(r, opts)
+	if runErr != nil {
+		l.Debugf("Not recording the hook %s of unit %s: %v", name, opts.WorkingDir, runErr)
+
+		return
+	}
+
+	exitCode := 0
+	if err != nil {
+		exitCode = -1
+
+		if code, codeErr := util.GetExitCode(err); codeErr == nil {
+			exitCode = code
+		}
+	}
+
+	run.AddHook(report.HookRun{
+		Name:     name,
+		Type:     hookType,
+		Duration: duration,
+		ExitCode: exitCode,
+	})
+}
+
 func shouldRunHook(hook config.Hook, terragruntOptions *options.TerragruntOptions, previousExecErrors *errors.MultiError) bool {
 	// if there's no previous error, execute command
 	// OR if a previous error DID happen AND we want to run anyways
@@ -33,6 +33,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 	"github.com/gruntwork-io/terragrunt/options"
@@ -66,6 +67,21 @@ var TerraformCommandsThatUseState = []string{
 	"state",
 }
 
+// TerraformCommandsThatMutateState are the commands before which the state is snapshotted.
+var TerraformCommandsThatMutateState = []string{
+	tf.CommandNameApply,
+	tf.CommandNameDestroy,
+	tf.CommandNameImport,
+}
+
+// TerraformStateSubcommandsThatMutateState are the `state` subcommands before which the state is snapshotted.
+var TerraformStateSubcommandsThatMutateState = []string{
+	tf.CommandNameMove,
+	"rm",
+	tf.CommandNamePush,
+	"replace-provider",
+}
+
 var TerraformCommandsThatDoNotNeedInit = []string{
 	"version",
 	"terragrunt-info",
@@ -142,7 +158,7 @@ func run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, r *
 	terragruntOptionsClone.TerraformCommand = CommandNameTerragruntReadConfig
 
 	if err = terragruntOptionsClone.RunWithErrorHandling(ctx, l, r, func() error {
-		return processHooks(ctx, l, terragruntConfig.Terraform.GetAfterHooks(), terragruntOptionsClone, terragruntConfig, nil)
+		return processHooks(ctx, l, report.HookTypeAfter, terragruntConfig.Terraform.GetAfterHooks(), terragruntOptionsClone, terragruntConfig, r, nil)
 	}); err != nil {
 		return target.runErrorCallback(l, opts, terragruntConfig, err)
 	}
@@ -257,9 +273,31 @@ func run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, r *
 		}
 	}
 
-	if err := opts.RunWithErrorHandling(ctx, l, r, func() error {
-		return runTerragruntWithConfig(ctx, l, opts, updatedTerragruntOptions, terragruntConfig, r, target)
+	runCtx := ctx
+
+	// Bound the run of the unit by its `timeout` attribute. Once it expires, the running command is interrupted,
+	// and terminated if it doesn't exit in time.
+	if timeout := terragruntConfig.TimeoutDuration(); timeout > 0 {
+		var cancel context.CancelFunc
+
+		runCtx, cancel = context.WithTimeoutCause(ctx, timeout, config.TimeoutError{Timeout: timeout})
+		defer cancel()
+	}
+
+	if err := opts.RunWithErrorHandling(runCtx, l, r, func() error {
+		started := time.Now()
+		err := runTerragruntWithConfig(runCtx, l, opts, updatedTerragruntOptions, terragruntConfig, r, target)
+
+		if opts.Experiments.Evaluate(experiment.Report) {
+			recordAttempt(l, opts, r, time.Since(started), err)
+		}
+
+		return err
 	}); err != nil {
+		if timeoutErr := new(config.TimeoutError); errors.As(context.Cause(runCtx), timeoutErr) {
+			err = errors.New(config.TimeoutError{Timeout: timeoutErr.Timeout, Err: err})
+		}
+
 		return target.runErrorCallback(l, opts, terragruntConfig, err)
 	}
 
@@ -358,7 +396,11 @@ func runTerragruntWithConfig(
 		return err
 	}
 
-	return RunActionWithHooks(ctx, l, "terraform", opts, cfg, func(ctx context.Context) error {
+	if err := snapshotState(ctx, l, opts, cfg); err != nil {
+		return err
+	}
+
+	return RunActionWithHooks(ctx, l, "terraform", opts, cfg, r, func(ctx context.Context) error {
 		runTerraformError := RunTerraformWithRetry(ctx, l, opts, r)
 
 		var lockFileError error
@@ -377,6 +419,33 @@ func runTerragruntWithConfig(
 	})
 }
 
+// snapshotState saves a snapshot of the unit state before the commands that mutate it, if state snapshots are enabled.
+func snapshotState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, cfg *config.TerragruntConfig) error {
+	if cfg.RemoteState == nil || !snapshot.Enabled(opts) || !isStateMutatingCommand(opts.TerraformCliArgs) {
+		return nil
+	}
+
+	command := opts.TerraformCliArgs.First()
+	if command == tf.CommandNameState {
+		command += "-" + opts.TerraformCliArgs.Second()
+	}
+
+	if err := snapshot.Take(ctx, l, opts, cfg.RemoteState, command); err != nil {
+		return errors.Errorf("failed to snapshot state before %s: %w", command, err)
+	}
+
+	return nil
+}
+
+// isStateMutatingCommand returns true if the given OpenTofu/Terraform command mutates the state.
+func isStateMutatingCommand(args cli.Args) bool {
+	if args.First() == tf.CommandNameState {
+		return util.ListContainsElement(TerraformStateSubcommandsThatMutateState, args.Second())
+	}
+
+	return util.ListContainsElement(TerraformCommandsThatMutateState, args.First())
+}
+
 // confirmActionWithDependentModules - Show warning with list of dependent modules from current module before destroy
 func confirmActionWithDependentModules(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, cfg *config.TerragruntConfig) bool {
 	modules := configstack.FindWhereWorkingDirIsIncluded(ctx, l, opts, cfg)
@@ -443,10 +512,11 @@ func ShouldCopyLockFile(args cli.Args, terraformConfig *config.TerraformConfig)
 }
 
 // RunActionWithHooks runs the given action function surrounded by hooks. That is, run the before hooks first, then, if there were no
-// errors, run the action, and finally, run the after hooks. Return any errors hit from the hooks or action.
-func RunActionWithHooks(ctx context.Context, l log.Logger, description string, terragruntOptions *options.TerragruntOptions, terragruntConfig *config.TerragruntConfig, action func(ctx context.Context) error) error {
+// errors, run the action, and finally, run the after hooks. Return any errors hit from the hooks or action. The hooks are
+// recorded in the run of the unit in the report, unless the report is nil.
+func RunActionWithHooks(ctx context.Context, l log.Logger, description string, terragruntOptions *options.TerragruntOptions, terragruntConfig *config.TerragruntConfig, r *report.Report, action func(ctx context.Context) error) error {
 	var allErrors *errors.MultiError
-	beforeHookErrors := processHooks(ctx, l, terragruntConfig.Terraform.GetBeforeHooks(), terragruntOptions, terragruntConfig, allErrors)
+	beforeHookErrors := processHooks(ctx, l, report.HookTypeBefore, terragruntConfig.Terraform.GetBeforeHooks(), terragruntOptions, terragruntConfig, r, allErrors)
 	allErrors = allErrors.Append(beforeHookErrors)
 
 	var actionErrors error
@@ -457,8 +527,8 @@ func RunActionWithHooks(ctx context.Context, l log.Logger, description string, t
 		l.Errorf("Errors encountered running before_hooks. Not running '%s'.", description)
 	}
 
-	postHookErrors := processHooks(ctx, l, terragruntConfig.Terraform.GetAfterHooks(), terragruntOptions, terragruntConfig, allErrors)
-	errorHookErrors := processErrorHooks(ctx, l, terragruntConfig.Terraform.GetErrorHooks(), terragruntOptions, allErrors)
+	postHookErrors := processHooks(ctx, l, report.HookTypeAfter, terragruntConfig.Terraform.GetAfterHooks(), terragruntOptions, terragruntConfig, r, allErrors)
+	errorHookErrors := processErrorHooks(ctx, l, terragruntConfig.Terraform.GetErrorHooks(), terragruntOptions, r, allErrors)
 	allErrors = allErrors.Append(postHookErrors, errorHookErrors)
 
 	return allErrors.ErrorOrNil()
@@ -489,7 +559,14 @@ func SetTerragruntInputsAsEnvVars(l log.Logger, opts *options.TerragruntOptions,
 func RunTerraformWithRetry(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, r *report.Report) error {
 	// Retry the command configurable time with sleep in between
 	for range opts.RetryMaxAttempts {
-		if out, err := tf.RunCommandWithOutput(ctx, l, opts, opts.TerraformCliArgs...); err != nil {
+		started := time.Now()
+		out, err := tf.RunCommandWithOutput(ctx, l, opts, opts.TerraformCliArgs...)
+
+		if opts.Experiments.Evaluate(experiment.Report) && opts.TerraformCliArgs.First() == tf.CommandNameInit {
+			recordInitDuration(l, opts, r, time.Since(started))
+		}
+
+		if err != nil {
 			if out == nil || !IsRetryable(opts, out) {
 				l.Errorf("%s invocation failed in %s", opts.TerraformImplementation, opts.WorkingDir)
 
@@ -519,6 +596,10 @@ func RunTerraformWithRetry(ctx context.Context, l log.Logger, opts *options.Terr
 				}
 			}
 		} else {
+			if opts.Experiments.Evaluate(experiment.Report) && opts.TerraformCommand == tf.CommandNamePlan && out != nil {
+				recordPlanChanges(l, opts, r, out)
+			}
+
 			return nil
 		}
 	}
@@ -526,6 +607,53 @@ func RunTerraformWithRetry(ctx context.Context, l log.Logger, opts *options.Terr
 	return errors.New(MaxRetriesExceeded{opts})
 }
 
+// reportRun returns the run of the unit in the report. It is looked up by the directory of the configuration of the
+// unit, as the working directory of the options is the one the source of the unit was downloaded to, if any.
+func reportRun(r *report.Report, opts *options.TerragruntOptions) (*report.Run, error) {
+	return r.GetRun(filepath.Dir(opts.TerragruntConfigPath))
+}
+
+// recordPlanChanges records the resource changes of the plan in the run of the unit, if the report has one.
+func recordPlanChanges(l log.Logger, opts *options.TerragruntOptions, r *report.Report, out *util.CmdOutput) {
+	changes := tf.ParsePlanChanges(out.Stdout.String())
+	if changes == nil {
+		return
+	}
+
+	run, err := reportRun(r, opts)
+	if err != nil {
+		l.Debugf("Not recording the plan changes of unit %s: %v", opts.WorkingDir, err)
+
+		return
+	}
+
+	run.SetPlanChanges(changes)
+}
+
+// recordInitDuration adds the duration of a run of `init` to the run of the unit, if the report has one.
+func recordInitDuration(l log.Logger, opts *options.TerragruntOptions, r *report.Report, duration time.Duration) {
+	run, err := reportRun(r, opts)
+	if err != nil {
+		l.Debugf("Not recording the init duration of unit %s: %v", opts.WorkingDir, err)
+
+		return
+	}
+
+	run.AddInitDuration(duration)
+}
+
+// recordAttempt records an attempt of running the unit in its run, if the report has one.
+func recordAttempt(l log.Logger, opts *options.TerragruntOptions, r *report.Report, duration time.Duration, err error) {
+	run, runErr := reportRun(r, opts)
+	if runErr != nil {
+		l.Debugf("Not recording the attempt of unit %s: %v", opts.WorkingDir, runErr)
+
+		return
+	}
+
+	run.AddAttempt(duration, err)
+}
+
 // IsRetryable checks whether there was an error and if the output matches any of the configured RetryableErrors
 func IsRetryable(opts *options.TerragruntOptions, out *util.CmdOutput) bool {
 	if !opts.AutoRetry {
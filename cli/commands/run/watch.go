@@ -0,0 +1,65 @@
+package run
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/configstack"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/watch"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Watch runs the command in the unit, then watches the directory of the unit, the files it includes or reads, and its
+// local module source, and runs the command again on each change, until the context is done. Each run parses the
+// configuration of the unit again, and reads the outputs of its dependencies again.
+func Watch(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	watcher := watch.New(nil)
+
+	for {
+		// Each run gets its own copy of the options, as running the unit updates them, and fresh caches, so it reads the
+		// current outputs of the dependencies and the current results of `run_cmd`.
+		config.ClearOutputCache()
+
+		runCtx := config.WithConfigValues(ctx)
+		runOpts := opts.Clone()
+
+		if err := Run(runCtx, l, runOpts, report.NewReport().WithWorkingDir(runOpts.WorkingDir)); err != nil {
+			// Keep watching, so the error can be fixed.
+			l.Errorf("Run failed: %v", err)
+		}
+
+		// Watch the files the unit started to depend on too, like a newly included file.
+		watcher.SetPaths(append(watcher.Paths(), watchedPaths(ctx, l, opts)...))
+
+		l.Infof("Watching %d path(s) for changes", len(watcher.Paths()))
+
+		changed, err := watcher.Wait(ctx)
+		if err != nil {
+			// The watch only stops when the context is done, e.g. on an interrupt, which is not an error.
+			return nil //nolint:nilerr
+		}
+
+		l.Infof("Detected changes in %s", strings.Join(changed, ", "))
+	}
+}
+
+// watchedPaths returns the paths the unit depends on. Only the directory of the unit is returned if its configuration
+// can't be parsed, so the watch can pick up the fix.
+func watchedPaths(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) []string {
+	parseOpts := opts.Clone()
+	parseCtx := config.NewParsingContext(ctx, l, parseOpts).WithDecodeList(config.TerraformSource)
+
+	cfg, err := config.PartialParseConfigFile(parseCtx, l, parseOpts.TerragruntConfigPath, nil)
+	if err != nil {
+		l.Debugf("Failed to parse %s to find the files it depends on: %v", parseOpts.TerragruntConfigPath, err)
+
+		return []string{opts.WorkingDir}
+	}
+
+	module := &configstack.TerraformModule{Path: opts.WorkingDir, Config: *cfg}
+
+	return configstack.TerraformModules{module}.WatchedPaths(opts)
+}
@@ -35,6 +35,11 @@ const (
 	NonInteractiveFlagName = "non-interactive"
 	WorkingDirFlagName     = "working-dir"
 
+	// Parse cache related flags.
+
+	ParseCacheFlagName    = "parse-cache"
+	ParseCacheDirFlagName = "parse-cache-dir"
+
 	// Strict Mode related flags.
 
 	StrictModeFlagName    = "strict-mode"
@@ -238,6 +243,22 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 				EnvVars:  flags.Prefix{}.EnvVars(DeprecatedTFInputFlagName),
 			}, nil, terragruntPrefixControl)),
 
+		// Parse cache flags.
+
+		flags.NewFlag(&cli.BoolFlag{
+			Name:        ParseCacheFlagName,
+			EnvVars:     tgPrefix.EnvVars(ParseCacheFlagName),
+			Destination: &opts.ParseCache,
+			Usage:       "Cache the results of the partial parses of configurations on disk, and reuse them while the files they depend on don't change.",
+		}),
+
+		flags.NewFlag(&cli.GenericFlag[string]{
+			Name:        ParseCacheDirFlagName,
+			EnvVars:     tgPrefix.EnvVars(ParseCacheDirFlagName),
+			Destination: &opts.ParseCacheDir,
+			Usage:       "The path to the directory of the parse cache. Default is the 'parse-cache' directory of the user cache directory.",
+		}),
+
 		// Experiment Mode flags.
 
 		flags.NewFlag(&cli.BoolFlag{
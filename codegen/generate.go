@@ -69,6 +69,11 @@ const (
 	EncryptionKeyProviderKey = "key_provider"
 	encryptionResourceName   = "default"
 
+	// EncryptionFallbackKey is the key of the encryption map holding the key provider that the state and plan
+	// are decrypted with when decrypting them with the primary key provider fails.
+	EncryptionFallbackKey          = "fallback"
+	encryptionFallbackResourceName = "fallback"
+
 	encryptionMethodKey     = "method"
 	encryptionDefaultMethod = "aes_gcm"
 
@@ -463,55 +468,72 @@ func RemoteStateConfigToTerraformCode(backend string, config map[string]any, enc
 		return nil, errors.New(EncryptionKeyProviderKey + " is mandatory but not found in the encryption map")
 	}
 
-	keyProviderTraversal := hcl.Traversal{
-		hcl.TraverseRoot{Name: EncryptionKeyProviderKey},
-		hcl.TraverseAttr{Name: keyProvider},
-		hcl.TraverseAttr{Name: encryptionResourceName},
-	}
+	var fallbackKeyProvider string
 
-	methodTraversal := hcl.Traversal{
-		hcl.TraverseRoot{Name: encryptionMethodKey},
-		hcl.TraverseAttr{Name: encryptionDefaultMethod},
-		hcl.TraverseAttr{Name: encryptionResourceName},
+	fallback, hasFallback := encryption[EncryptionFallbackKey].(map[string]any)
+	if hasFallback {
+		if fallbackKeyProvider, found = fallback[EncryptionKeyProviderKey].(string); !found {
+			return nil, errors.New(EncryptionKeyProviderKey + " is mandatory but not found in the encryption fallback map")
+		}
 	}
 
 	// encryption block
 	encryptionBlock := terraformBlock.AppendNewBlock(encryptionBlockName, nil)
 	encryptionBlockBody := encryptionBlock.Body()
 
-	// Append key_provider block
-	keyProviderBlockBody := encryptionBlockBody.AppendNewBlock(EncryptionKeyProviderKey, []string{keyProvider, encryptionResourceName}).Body()
+	// Append key_provider blocks
+	if err := appendEncryptionKeyProvider(encryptionBlockBody, keyProvider, encryptionResourceName, encryption); err != nil {
+		return nil, err
+	}
 
-	// Append method block
-	methodBlock := encryptionBlockBody.AppendNewBlock(encryptionMethodKey, []string{encryptionDefaultMethod, encryptionResourceName}).Body()
-	methodBlock.SetAttributeTraversal(encryptionKeysAttributeName, keyProviderTraversal)
+	if hasFallback {
+		if err := appendEncryptionKeyProvider(encryptionBlockBody, fallbackKeyProvider, encryptionFallbackResourceName, fallback); err != nil {
+			return nil, err
+		}
+	}
+
+	// Append method blocks
+	methodTraversal := appendEncryptionMethod(encryptionBlockBody, keyProvider, encryptionResourceName)
+
+	var fallbackMethodTraversal hcl.Traversal
+
+	if hasFallback {
+		fallbackMethodTraversal = appendEncryptionMethod(encryptionBlockBody, fallbackKeyProvider, encryptionFallbackResourceName)
+	}
+
+	// Append state and plan blocks
+	for _, blockName := range []string{encryptionStateBlockName, encryptionPlanBlockName} {
+		blockBody := encryptionBlockBody.AppendNewBlock(blockName, nil).Body()
+		blockBody.SetAttributeTraversal(encryptionMethodKey, methodTraversal)
+
+		if hasFallback {
+			blockBody.AppendNewBlock(EncryptionFallbackKey, nil).Body().SetAttributeTraversal(encryptionMethodKey, fallbackMethodTraversal)
+		}
+	}
 
-	// Append state block
-	stateBlock := encryptionBlockBody.AppendNewBlock(encryptionStateBlockName, nil).Body()
-	stateBlock.SetAttributeTraversal(encryptionMethodKey, methodTraversal)
+	return f.Bytes(), nil
+}
 
-	// Append plan block
-	planBlock := encryptionBlockBody.AppendNewBlock(encryptionPlanBlockName, nil).Body()
-	planBlock.SetAttributeTraversal(encryptionMethodKey, methodTraversal)
+// appendEncryptionKeyProvider appends the `key_provider` block with the given name to the encryption block body,
+// filled with the ordered properties of the key provider.
+func appendEncryptionKeyProvider(encryptionBlockBody *hclwrite.Body, keyProvider, name string, properties map[string]any) error {
+	keyProviderBlockBody := encryptionBlockBody.AppendNewBlock(EncryptionKeyProviderKey, []string{keyProvider, name}).Body()
 
-	var encryptionKeys = make([]string, 0, len(encryption))
+	var keys = make([]string, 0, len(properties))
 
-	for key := range encryption {
-		encryptionKeys = append(encryptionKeys, key)
+	for key := range properties {
+		keys = append(keys, key)
 	}
 
-	sort.Strings(encryptionKeys)
+	sort.Strings(keys)
 
 	// Fill key_provider block with ordered attributes
-	for _, key := range encryptionKeys {
-		if key == EncryptionKeyProviderKey {
+	for _, key := range keys {
+		if key == EncryptionKeyProviderKey || key == EncryptionFallbackKey {
 			continue
 		}
 
-		value, ok := encryption[key]
-		if !ok {
-			continue
-		}
+		value := properties[key]
 
 		// Skip basic types with zero values
 		if value == "" || value == 0 {
@@ -520,15 +542,32 @@ func RemoteStateConfigToTerraformCode(backend string, config map[string]any, enc
 
 		ctyVal, err := convertValue(value)
 		if err != nil {
-			return nil, errors.New(err)
+			return errors.New(err)
 		}
 
-		if keyProviderBlockBody != nil {
-			keyProviderBlockBody.SetAttributeValue(key, ctyVal.Value)
-		}
+		keyProviderBlockBody.SetAttributeValue(key, ctyVal.Value)
 	}
 
-	return f.Bytes(), nil
+	return nil
+}
+
+// appendEncryptionMethod appends the `method` block with the given name, using the keys of the key provider with
+// the same name, to the encryption block body and returns the traversal referencing it.
+func appendEncryptionMethod(encryptionBlockBody *hclwrite.Body, keyProvider, name string) hcl.Traversal {
+	keyProviderTraversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: EncryptionKeyProviderKey},
+		hcl.TraverseAttr{Name: keyProvider},
+		hcl.TraverseAttr{Name: name},
+	}
+
+	methodBlock := encryptionBlockBody.AppendNewBlock(encryptionMethodKey, []string{encryptionDefaultMethod, name}).Body()
+	methodBlock.SetAttributeTraversal(encryptionKeysAttributeName, keyProviderTraversal)
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: encryptionMethodKey},
+		hcl.TraverseAttr{Name: encryptionDefaultMethod},
+		hcl.TraverseAttr{Name: name},
+	}
 }
 
 func convertValue(v any) (ctyjson.SimpleJSONValue, error) {
@@ -58,6 +58,58 @@ func TestRemoteStateConfigToTerraformCode(t *testing.T) {
     }
   }
 }
+`)
+	expectedExternalKeyProvider := []byte(`terraform {
+  backend "local" {
+    path = "terraform.tfstate"
+  }
+  encryption {
+    key_provider "external" "default" {
+      command = ["./get-key.sh", "--format", "json"]
+    }
+    method "aes_gcm" "default" {
+      keys = key_provider.external.default
+    }
+    state {
+      method = method.aes_gcm.default
+    }
+    plan {
+      method = method.aes_gcm.default
+    }
+  }
+}
+`)
+	expectedFallbackKeyProvider := []byte(`terraform {
+  backend "local" {
+    path = "terraform.tfstate"
+  }
+  encryption {
+    key_provider "openbao" "default" {
+      key_name = "new-key"
+    }
+    key_provider "pbkdf2" "fallback" {
+      passphrase = "old-passphrase"
+    }
+    method "aes_gcm" "default" {
+      keys = key_provider.openbao.default
+    }
+    method "aes_gcm" "fallback" {
+      keys = key_provider.pbkdf2.fallback
+    }
+    state {
+      method = method.aes_gcm.default
+      fallback {
+        method = method.aes_gcm.fallback
+      }
+    }
+    plan {
+      method = method.aes_gcm.default
+      fallback {
+        method = method.aes_gcm.fallback
+      }
+    }
+  }
+}
 `)
 	expectedEmptyEncryption := []byte(`terraform {
   backend "empty" {
@@ -134,6 +186,49 @@ func TestRemoteStateConfigToTerraformCode(t *testing.T) {
 			expectedEmptyConfig,
 			false,
 		},
+		{
+			"remote-state-encryption-list-attribute",
+			"local",
+			map[string]any{
+				"path": "terraform.tfstate",
+			},
+			map[string]any{
+				"key_provider": "external",
+				"command":      []string{"./get-key.sh", "--format", "json"},
+			},
+			expectedExternalKeyProvider,
+			false,
+		},
+		{
+			"remote-state-encryption-fallback",
+			"local",
+			map[string]any{
+				"path": "terraform.tfstate",
+			},
+			map[string]any{
+				"key_provider": "openbao",
+				"key_name":     "new-key",
+				"fallback": map[string]any{
+					"key_provider": "pbkdf2",
+					"passphrase":   "old-passphrase",
+				},
+			},
+			expectedFallbackKeyProvider,
+			false,
+		},
+		{
+			"remote-state-encryption-fallback-missing-key-provider",
+			"local",
+			map[string]any{},
+			map[string]any{
+				"key_provider": "openbao",
+				"fallback": map[string]any{
+					"passphrase": "old-passphrase",
+				},
+			},
+			[]byte(""),
+			true,
+		},
 		{
 			"remote-state-encryption-empty",
 			"empty",
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// DefaultConcurrencyWeight is the weight of units without a `concurrency` block, or without a `weight` attribute.
+const DefaultConcurrencyWeight = 1
+
+// ConcurrencyConfig represents the `concurrency` block, which limits how many units run at once during `run --all`.
+type ConcurrencyConfig struct {
+	// Group is the concurrency group of the unit. The units of a group share the group limit.
+	Group *string `cty:"group" hcl:"group,attr"`
+	// Limit is the maximum total weight of the units of the group running at once.
+	Limit *int `cty:"limit" hcl:"limit,attr"`
+	// Weight is how many slots of `--parallelism`, and of the group limit, the unit takes while it runs.
+	Weight *int `cty:"weight" hcl:"weight,attr"`
+}
+
+// Clone returns a copy of the ConcurrencyConfig used in deep copy
+func (c *ConcurrencyConfig) Clone() *ConcurrencyConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &ConcurrencyConfig{
+		Group:  c.Group,
+		Limit:  c.Limit,
+		Weight: c.Weight,
+	}
+}
+
+// Merge merges the ConcurrencyConfig with another ConcurrencyConfig, prioritizing the other config
+func (c *ConcurrencyConfig) Merge(other *ConcurrencyConfig) {
+	if c == nil || other == nil {
+		return
+	}
+
+	if other.Group != nil {
+		c.Group = other.Group
+	}
+
+	if other.Limit != nil {
+		c.Limit = other.Limit
+	}
+
+	if other.Weight != nil {
+		c.Weight = other.Weight
+	}
+}
+
+// Validate returns an error if the limit or the weight are not positive, or if a limit is set without a group.
+func (c *ConcurrencyConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Group != nil && *c.Group == "" {
+		return errors.Errorf("the `group` attribute of the `%s` block must not be empty", MetadataConcurrency)
+	}
+
+	if c.Limit != nil {
+		if c.Group == nil {
+			return errors.Errorf("the `limit` attribute of the `%s` block requires the `group` attribute", MetadataConcurrency)
+		}
+
+		if *c.Limit < 1 {
+			return errors.Errorf("the `limit` attribute of the `%s` block must be at least 1, got %d", MetadataConcurrency, *c.Limit)
+		}
+	}
+
+	if c.Weight != nil && *c.Weight < 1 {
+		return errors.Errorf("the `weight` attribute of the `%s` block must be at least 1, got %d", MetadataConcurrency, *c.Weight)
+	}
+
+	return nil
+}
+
+// GroupName returns the concurrency group of the unit, or an empty string if it does not belong to a group.
+func (c *ConcurrencyConfig) GroupName() string {
+	if c == nil || c.Group == nil {
+		return ""
+	}
+
+	return *c.Group
+}
+
+// GroupLimit returns the limit of the concurrency group of the unit, or 0 if it does not set one.
+func (c *ConcurrencyConfig) GroupLimit() int {
+	if c == nil || c.Limit == nil {
+		return 0
+	}
+
+	return *c.Limit
+}
+
+// UnitWeight returns the weight of the unit.
+func (c *ConcurrencyConfig) UnitWeight() int {
+	if c == nil || c.Weight == nil {
+		return DefaultConcurrencyWeight
+	}
+
+	return *c.Weight
+}
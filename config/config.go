@@ -66,6 +66,7 @@ const (
 	MetadataDownloadDir                 = "download_dir"
 	MetadataPreventDestroy              = "prevent_destroy"
 	MetadataSkip                        = "skip"
+	MetadataTimeout                     = "timeout"
 	MetadataIamRole                     = "iam_role"
 	MetadataIamAssumeRoleDuration       = "iam_assume_role_duration"
 	MetadataIamAssumeRoleSessionName    = "iam_assume_role_session_name"
@@ -84,6 +85,7 @@ const (
 	MetadataFeatureFlag                 = "feature"
 	MetadataExclude                     = "exclude"
 	MetadataErrors                      = "errors"
+	MetadataConcurrency                 = "concurrency"
 	MetadataRetry                       = "retry"
 	MetadataIgnore                      = "ignore"
 	MetadataValues                      = "values"
@@ -150,8 +152,10 @@ type TerragruntConfig struct {
 	RemoteState                 *remotestate.RemoteState
 	Dependencies                *ModuleDependencies
 	Exclude                     *ExcludeConfig
+	Concurrency                 *ConcurrencyConfig
 	PreventDestroy              *bool
 	Skip                        *bool
+	Timeout                     *string
 	GenerateConfigs             map[string]codegen.GenerateConfig
 	IamAssumeRoleDuration       *int64
 	RetrySleepIntervalSec       *int
@@ -560,6 +564,27 @@ func (cfg *TerragruntConfig) WriteTo(w io.Writer) (int64, error) {
 		rootBody.AppendBlock(errorsBlock)
 	}
 
+	// Handle concurrency block
+	if cfg.Concurrency != nil {
+		concurrencyBlock := hclwrite.NewBlock("concurrency", nil)
+		concurrencyBody := concurrencyBlock.Body()
+		concurrencyAsCty := cfgAsCty.GetAttr("concurrency")
+
+		if cfg.Concurrency.Group != nil {
+			concurrencyBody.SetAttributeValue("group", concurrencyAsCty.GetAttr("group"))
+		}
+
+		if cfg.Concurrency.Limit != nil {
+			concurrencyBody.SetAttributeValue("limit", concurrencyAsCty.GetAttr("limit"))
+		}
+
+		if cfg.Concurrency.Weight != nil {
+			concurrencyBody.SetAttributeValue("weight", concurrencyAsCty.GetAttr("weight"))
+		}
+
+		rootBody.AppendBlock(concurrencyBlock)
+	}
+
 	// Handle catalog block
 	if cfg.Catalog != nil {
 		catalogBlock := hclwrite.NewBlock("catalog", nil)
@@ -602,6 +627,10 @@ func (cfg *TerragruntConfig) WriteTo(w io.Writer) (int64, error) {
 		rootBody.SetAttributeValue("skip", cfgAsCty.GetAttr("skip"))
 	}
 
+	if cfg.Timeout != nil {
+		rootBody.SetAttributeValue("timeout", cfgAsCty.GetAttr("timeout"))
+	}
+
 	if cfg.IamRole != "" {
 		rootBody.SetAttributeValue("iam_role", cfgAsCty.GetAttr("iam_role"))
 	}
@@ -664,6 +693,7 @@ type terragruntConfigFile struct {
 	DownloadDir              *string             `hcl:"download_dir,attr"`
 	PreventDestroy           *bool               `hcl:"prevent_destroy,attr"`
 	Skip                     *bool               `hcl:"skip,attr"`
+	Timeout                  *string             `hcl:"timeout,attr"`
 	IamRole                  *string             `hcl:"iam_role,attr"`
 	IamAssumeRoleDuration    *int64              `hcl:"iam_assume_role_duration,attr"`
 	IamAssumeRoleSessionName *string             `hcl:"iam_assume_role_session_name,attr"`
@@ -672,6 +702,7 @@ type terragruntConfigFile struct {
 	FeatureFlags             []*FeatureFlag      `hcl:"feature,block"`
 	Exclude                  *ExcludeConfig      `hcl:"exclude,block"`
 	Errors                   *ErrorsConfig       `hcl:"errors,block"`
+	Concurrency              *ConcurrencyConfig  `hcl:"concurrency,block"`
 
 	// We allow users to configure code generation via blocks:
 	//
@@ -1166,6 +1197,8 @@ func ReadTerragruntConfig(ctx context.Context, l log.Logger, terragruntOptions *
 func ParseConfigFile(ctx *ParsingContext, l log.Logger, configPath string, includeFromChild *IncludeConfig) (*TerragruntConfig, error) {
 	var config *TerragruntConfig
 
+	ParseInputsFromContext(ctx).AddFile(configPath)
+
 	hclCache := cache.ContextCache[*hclparse.File](ctx, HclCacheContextKey)
 
 	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "parse_config_file", map[string]any{
@@ -1337,6 +1370,10 @@ func ParseConfig(ctx *ParsingContext, l log.Logger, file *hclparse.File, include
 		errs = errs.Append(err)
 	}
 
+	if config == nil {
+		return nil, errs.ErrorOrNil()
+	}
+
 	// If this file includes another, parse and merge it. Otherwise, just return this config.
 	// If there have been errors during this parse, don't attempt to parse the included config.
 	if ctx.TrackInclude != nil {
@@ -1702,6 +1739,15 @@ func convertToTerragruntConfig(ctx *ParsingContext, configPath string, terragrun
 		terragruntConfig.SetFieldMetadata(MetadataSkip, defaultMetadata)
 	}
 
+	if terragruntConfigFromFile.Timeout != nil {
+		if _, err := ParseTimeout(*terragruntConfigFromFile.Timeout); err != nil {
+			return nil, err
+		}
+
+		terragruntConfig.Timeout = terragruntConfigFromFile.Timeout
+		terragruntConfig.SetFieldMetadata(MetadataTimeout, defaultMetadata)
+	}
+
 	if terragruntConfigFromFile.IamRole != nil {
 		terragruntConfig.IamRole = *terragruntConfigFromFile.IamRole
 		terragruntConfig.SetFieldMetadata(MetadataIamRole, defaultMetadata)
@@ -1744,6 +1790,15 @@ func convertToTerragruntConfig(ctx *ParsingContext, configPath string, terragrun
 		terragruntConfig.SetFieldMetadata(MetadataErrors, defaultMetadata)
 	}
 
+	if terragruntConfigFromFile.Concurrency != nil {
+		if err := terragruntConfigFromFile.Concurrency.Validate(); err != nil {
+			return nil, err
+		}
+
+		terragruntConfig.Concurrency = terragruntConfigFromFile.Concurrency
+		terragruntConfig.SetFieldMetadata(MetadataConcurrency, defaultMetadata)
+	}
+
 	generateBlocks := []terragruntGenerateBlock{}
 	generateBlocks = append(generateBlocks, terragruntConfigFromFile.GenerateBlocks...)
 
@@ -2118,3 +2173,18 @@ func ParseRemoteState(ctx context.Context, l log.Logger, opts *options.Terragrun
 
 	return cfg.GetRemoteState(l, opts)
 }
+
+// ParseRemoteStateString parses the given Terragrunt config as if it was located at `opts.TerragruntConfigPath`
+// and returns the `remote_state` block. This allows to evaluate the remote state of a unit at a location
+// where its config file does not exist, for example before the unit was moved.
+func ParseRemoteStateString(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, configString string) (*remotestate.RemoteState, error) {
+	ctx = tf.ContextWithTerraformCommandHook(ctx, nil)
+	parsingCtx := NewParsingContext(ctx, l, opts).WithParseOption(DefaultParserOptions(l, opts))
+
+	cfg, err := ParseConfigString(parsingCtx, l, opts.TerragruntConfigPath, configString, nil) //nolint:contextcheck
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.GetRemoteState(l, opts)
+}
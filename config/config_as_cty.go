@@ -59,6 +59,15 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 		output[MetadataExclude] = excludeConfigCty
 	}
 
+	concurrencyConfigCty, err := concurrencyConfigAsCty(config.Concurrency)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	if concurrencyConfigCty != cty.NilVal {
+		output[MetadataConcurrency] = concurrencyConfigCty
+	}
+
 	errorsConfigCty, err := errorsConfigAsCty(config.Errors)
 	if err != nil {
 		return cty.NilVal, err
@@ -99,6 +108,10 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 		output[MetadataPreventDestroy] = goboolToCty(*config.PreventDestroy)
 	}
 
+	if config.Timeout != nil {
+		output[MetadataTimeout] = gostringToCty(*config.Timeout)
+	}
+
 	dependencyCty, err := dependencyBlocksAsCty(config.TerragruntDependencies)
 	if err != nil {
 		return cty.NilVal, err
@@ -232,6 +245,12 @@ func TerragruntConfigAsCtyWithMetadata(config *TerragruntConfig) (cty.Value, err
 		}
 	}
 
+	if config.Timeout != nil {
+		if err := wrapWithMetadata(config, *config.Timeout, MetadataTimeout, &output); err != nil {
+			return cty.NilVal, err
+		}
+	}
+
 	if err := wrapWithMetadata(config, config.RetryableErrors, MetadataRetryableErrors, &output); err != nil {
 		return cty.NilVal, err
 	}
@@ -547,6 +566,15 @@ func excludeConfigAsCty(config *ExcludeConfig) (cty.Value, error) {
 	return goTypeToCty(configCty)
 }
 
+// concurrencyConfigAsCty serialize concurrency configuration to a cty Value.
+func concurrencyConfigAsCty(config *ConcurrencyConfig) (cty.Value, error) {
+	if config == nil {
+		return cty.NilVal, nil
+	}
+
+	return goTypeToCty(config)
+}
+
 // CtyTerraformConfig is an alternate representation of TerraformConfig that converts internal blocks into a map that
 // maps the name to the underlying struct, as opposed to a list representation.
 type CtyTerraformConfig struct {
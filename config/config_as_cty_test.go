@@ -23,7 +23,11 @@ func TestTerragruntConfigAsCtyDrift(t *testing.T) {
 
 	testSource := "./foo"
 	testTrue := true
+	testTimeout := "30m"
 	testFalse := false
+	testGroup := "database"
+	testLimit := 2
+	testWeight := 1
 	mockOutputs := cty.Zero
 	mockOutputsAllowedTerraformCommands := []string{"init"}
 	dependentModulesPath := []*string{&testSource}
@@ -88,6 +92,7 @@ func TestTerragruntConfigAsCtyDrift(t *testing.T) {
 		DownloadDir:    ".terragrunt-cache",
 		PreventDestroy: &testTrue,
 		Skip:           &testTrue,
+		Timeout:        &testTimeout,
 		IamRole:        "terragruntRole",
 		Inputs: map[string]any{
 			"aws_region": "us-east-1",
@@ -131,6 +136,11 @@ func TestTerragruntConfigAsCtyDrift(t *testing.T) {
 				},
 			},
 		},
+		Concurrency: &config.ConcurrencyConfig{
+			Group:  &testGroup,
+			Limit:  &testLimit,
+			Weight: &testWeight,
+		},
 		GenerateConfigs: map[string]codegen.GenerateConfig{
 			"provider": {
 				Path:          "foo",
@@ -283,6 +293,8 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "download_dir", true
 	case "PreventDestroy":
 		return "prevent_destroy", true
+	case "Timeout":
+		return "timeout", true
 	case "Skip":
 		return "skip", true
 	case "IamRole":
@@ -323,6 +335,8 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "exclude", true
 	case "Errors":
 		return "errors", true
+	case "Concurrency":
+		return "concurrency", true
 	default:
 		t.Fatalf("Unknown struct property: %s", fieldName)
 		// This should not execute
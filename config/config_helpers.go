@@ -194,6 +194,8 @@ func createTerragruntEvalContext(ctx *ParsingContext, l log.Logger, configPath s
 	maps.Copy(functions, terragruntFunctions)
 	maps.Copy(functions, ctx.PredefinedFunctions)
 
+	wrapFunctionsWithParseInputs(ctx, functions, tfscope.BaseDir)
+
 	evalCtx := &hcl.EvalContext{
 		Functions: functions,
 	}
@@ -404,6 +406,8 @@ func getEnvironmentVariable(ctx *ParsingContext, l log.Logger, parameters []stri
 		return "", errors.New(err)
 	}
 
+	ParseInputsFromContext(ctx).AddEnv(parameterMap.Name)
+
 	envValue, exists := ctx.TerragruntOptions.Env[parameterMap.Name]
 
 	if !exists {
@@ -490,6 +494,8 @@ func FindInParentFolders(
 			fileToFind = util.JoinPath(currentDir, fileToFindParam)
 		}
 
+		ParseInputsFromContext(ctx).AddFile(fileToFind)
+
 		if util.FileExists(fileToFind) {
 			return fileToFind, nil
 		}
@@ -682,6 +688,8 @@ func ParseTerragruntConfig(ctx *ParsingContext, l log.Logger, configPath string,
 	// proceed to parse the file as a terragrunt config file.
 	targetConfig := getCleanedTargetConfigPath(configPath, ctx.TerragruntOptions.TerragruntConfigPath)
 
+	ParseInputsFromContext(ctx).AddFile(targetConfig)
+
 	targetConfigFileExists := util.FileExists(targetConfig)
 
 	if !targetConfigFileExists && defaultVal == nil {
@@ -703,6 +711,7 @@ func ParseTerragruntConfig(ctx *ParsingContext, l log.Logger, configPath string,
 		path,
 		ctx.TerragruntOptions.WorkingDir,
 	)
+	ParseInputsFromContext(ctx).AddReadFile(path, ctx.TerragruntOptions.WorkingDir)
 
 	// We update the ctx of terragruntOptions to the config being read in.
 	l, opts, err := ctx.TerragruntOptions.CloneWithConfigPath(l, targetConfig)
@@ -916,6 +925,7 @@ func sopsDecryptFile(ctx *ParsingContext, l log.Logger, params []string) (string
 		path,
 		ctx.TerragruntOptions.WorkingDir,
 	)
+	ParseInputsFromContext(ctx).AddReadFile(path, ctx.TerragruntOptions.WorkingDir)
 
 	// Set environment variables from the TerragruntOptions.Env map.
 	// This is especially useful for integrations with things like the `auth-provider` flag,
@@ -1117,6 +1127,7 @@ func readTFVarsFile(ctx *ParsingContext, l log.Logger, args []string) (string, e
 		varFile,
 		ctx.TerragruntOptions.WorkingDir,
 	)
+	ParseInputsFromContext(ctx).AddReadFile(varFile, ctx.TerragruntOptions.WorkingDir)
 
 	fileContents, err := os.ReadFile(varFile)
 	if err != nil {
@@ -1168,6 +1179,7 @@ func markAsRead(ctx *ParsingContext, l log.Logger, args []string) (string, error
 		path,
 		ctx.TerragruntOptions.WorkingDir,
 	)
+	ParseInputsFromContext(ctx).AddReadFile(path, ctx.TerragruntOptions.WorkingDir)
 
 	return file, nil
 }
@@ -40,6 +40,7 @@ const (
 	EngineBlock
 	ExcludeBlock
 	ErrorsBlock
+	ConcurrencyBlock
 )
 
 // terragruntIncludeMultiple is a struct that can be used to only decode the include block with labels.
@@ -66,6 +67,12 @@ type terragruntErrors struct {
 	Remain hcl.Body      `hcl:",remain"`
 }
 
+// terragruntConcurrency is a struct that can be used to only decode the concurrency block.
+type terragruntConcurrency struct {
+	Concurrency *ConcurrencyConfig `hcl:"concurrency,block"`
+	Remain      hcl.Body           `hcl:",remain"`
+}
+
 // terragruntTerraform is a struct that can be used to only decode the terraform block.
 type terragruntTerraform struct {
 	Terraform *TerraformConfig `hcl:"terraform,block"`
@@ -280,6 +287,8 @@ func cliFlagsToCty(ctx *ParsingContext, flagByName map[string]*FeatureFlag) (map
 }
 
 func PartialParseConfigFile(ctx *ParsingContext, l log.Logger, configPath string, include *IncludeConfig) (*TerragruntConfig, error) {
+	ParseInputsFromContext(ctx).AddFile(configPath)
+
 	hclCache := cache.ContextCache[*hclparse.File](ctx, HclCacheContextKey)
 
 	fileInfo, err := os.Stat(configPath)
@@ -353,6 +362,7 @@ func TerragruntConfigFromPartialConfig(ctx *ParsingContext, l log.Logger, file *
 //   - FeatureFlagsBlock: Parses the `feature` block in the config
 //   - EngineBlock: Parses the `engine` block in the config
 //   - ExcludeBlock : Parses the `exclude` block in the config
+//   - ConcurrencyBlock : Parses the `concurrency` block in the config
 //
 // Note that the following blocks are always decoded:
 // - locals
@@ -643,6 +653,23 @@ func PartialParseConfig(ctx *ParsingContext, l log.Logger, file *hclparse.File,
 				output.Errors = decoded.Errors
 			}
 
+		case ConcurrencyBlock:
+			decoded := terragruntConcurrency{}
+
+			if err := file.Decode(&decoded, evalParsingContext); err != nil {
+				return nil, err
+			}
+
+			if err := decoded.Concurrency.Validate(); err != nil {
+				return nil, err
+			}
+
+			if output.Concurrency != nil {
+				output.Concurrency.Merge(decoded.Concurrency)
+			} else {
+				output.Concurrency = decoded.Concurrency
+			}
+
 		default:
 			return nil, InvalidPartialBlockName{decode}
 		}
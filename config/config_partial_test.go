@@ -388,3 +388,41 @@ dependency "ec2" {
 	require.NoError(t, err)
 	assert.Len(t, terragruntConfig.Dependencies.Paths, 1)
 }
+
+func TestPartialParseConcurrencyBlock(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+concurrency {
+  group  = "database"
+  limit  = 2
+  weight = 3
+}
+`
+
+	l := logger.CreateLogger()
+
+	ctx := config.NewParsingContext(t.Context(), l, mockOptionsForTest(t)).WithDecodeList(config.ConcurrencyBlock)
+	terragruntConfig, err := config.PartialParseConfigString(ctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "database", terragruntConfig.Concurrency.GroupName())
+	assert.Equal(t, 2, terragruntConfig.Concurrency.GroupLimit())
+	assert.Equal(t, 3, terragruntConfig.Concurrency.UnitWeight())
+}
+
+func TestPartialParseConcurrencyBlockLimitRequiresGroup(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+concurrency {
+  limit = 2
+}
+`
+
+	l := logger.CreateLogger()
+
+	ctx := config.NewParsingContext(t.Context(), l, mockOptionsForTest(t)).WithDecodeList(config.ConcurrencyBlock)
+	_, err := config.PartialParseConfigString(ctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.ErrorContains(t, err, "requires the `group` attribute")
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/codegen"
 	"github.com/gruntwork-io/terragrunt/config"
@@ -1301,6 +1302,38 @@ prevent_destroy = false
 	assert.False(t, *terragruntConfig.PreventDestroy)
 }
 
+func TestParseTerragruntConfigTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+timeout = "1h30m"
+`
+
+	l := createLogger()
+
+	ctx := config.NewParsingContext(t.Context(), l, mockOptionsForTest(t))
+	terragruntConfig, err := config.ParseConfigString(ctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1h30m", *terragruntConfig.Timeout)
+	assert.Equal(t, 90*time.Minute, terragruntConfig.TimeoutDuration())
+}
+
+func TestParseTerragruntConfigTimeoutInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, timeout := range []string{"soon", "0s", "-5m"} {
+		cfg := fmt.Sprintf("timeout = %q\n", timeout)
+
+		l := createLogger()
+
+		ctx := config.NewParsingContext(t.Context(), l, mockOptionsForTest(t))
+		_, err := config.ParseConfigString(ctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+		require.Error(t, err, timeout)
+		assert.Contains(t, err.Error(), "timeout", timeout)
+	}
+}
+
 func TestParseTerragruntConfigSkipTrue(t *testing.T) {
 	t.Parallel()
 
@@ -1881,3 +1914,37 @@ inputs = {
 	assert.Equal(t, terragruntConfig.RetryableErrors, rereadConfig.RetryableErrors)
 	assert.Equal(t, terragruntConfig.Inputs, rereadConfig.Inputs)
 }
+
+func TestParseRemoteStateStringAtMissingLocation(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	rootCfg := `
+remote_state {
+  backend = "s3"
+  config = {
+    bucket = "my-bucket"
+    key    = "${path_relative_to_include()}/terraform.tfstate"
+  }
+}
+`
+	unitCfg := `
+include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+`
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "root.hcl"), []byte(rootCfg), 0644))
+
+	// The unit config is evaluated at a location where it does not exist.
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, "old", "unit", config.DefaultTerragruntConfigPath))
+	require.NoError(t, err)
+
+	remoteState, err := config.ParseRemoteStateString(t.Context(), createLogger(), opts, unitCfg)
+	require.NoError(t, err)
+	require.NotNil(t, remoteState)
+
+	assert.Equal(t, "s3", remoteState.BackendName)
+	assert.Equal(t, "old/unit/terraform.tfstate", remoteState.BackendConfig["key"])
+}
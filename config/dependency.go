@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -349,8 +350,14 @@ func checkForDependencyBlockCyclesUsingDFS(
 		return nil
 	}
 
-	if util.ListContainsElement(*currentTraversalPaths, dependencyPath) {
-		return errors.New(DependencyCycleError(append(*currentTraversalPaths, dependencyPath)))
+	if index := slices.Index(*currentTraversalPaths, dependencyPath); index >= 0 {
+		// Only the end of the traversal, from the dependency, is part of the cycle.
+		cycle := append(slices.Clone((*currentTraversalPaths)[index:]), dependencyPath)
+
+		return errors.New(DependencyCycleError{
+			Paths:       cycle,
+			Diagnostics: DependencyCycleDiagnostics(dependencyBlockCycleEdges(ctx, l, cycle)),
+		})
 	}
 
 	*currentTraversalPaths = append(*currentTraversalPaths, dependencyPath)
@@ -380,6 +387,46 @@ func checkForDependencyBlockCyclesUsingDFS(
 	return nil
 }
 
+// dependencyBlockCycleEdges returns the edges of the cycle of dependency blocks between the configurations at the
+// given paths, finding the dependency block creating each edge by parsing the configurations again.
+func dependencyBlockCycleEdges(ctx *ParsingContext, l log.Logger, cycle []string) []DependencyCycleEdge {
+	edges := make([]DependencyCycleEdge, 0, len(cycle)-1)
+
+	for i := range len(cycle) - 1 {
+		edge := DependencyCycleEdge{ConfigPath: cycle[i], DependencyPath: cycle[i+1]}
+
+		l, configOpts, err := cloneTerragruntOptionsForDependency(ctx, l, edge.ConfigPath)
+		if err != nil {
+			edges = append(edges, edge)
+
+			continue
+		}
+
+		tgConfig, err := PartialParseConfigFile(ctx.WithTerragruntOptions(configOpts).WithDecodeList(DependencyBlock), l, edge.ConfigPath, nil)
+		if err != nil {
+			edges = append(edges, edge)
+
+			continue
+		}
+
+		for _, dependency := range tgConfig.TerragruntDependencies {
+			if dependency.ConfigPath.Type() == cty.String && getCleanedTargetConfigPath(dependency.ConfigPath.AsString(), edge.ConfigPath) == edge.DependencyPath {
+				edge.DependencyName = dependency.Name
+
+				break
+			}
+		}
+
+		for _, include := range tgConfig.ProcessedIncludes {
+			edge.IncludePaths = append(edge.IncludePaths, getCleanedTargetConfigPath(include.Path, edge.ConfigPath))
+		}
+
+		edges = append(edges, edge)
+	}
+
+	return edges
+}
+
 // Given the config path, return the list of config paths that are specified as dependency blocks in the config
 func getDependencyBlockConfigPathsByFilepath(ctx *ParsingContext, l log.Logger, configPath string) ([]string, error) {
 	// This will automatically parse everything needed to parse the dependency block configs, and load them as
@@ -481,6 +528,9 @@ func getTerragruntOutputIfAppliedElseConfiguredDefault(ctx *ParsingContext, l lo
 		return dependencyConfig.MockOutputs, nil
 	}
 
+	// The outputs depend on the state of the dependency, and the mock outputs on the command.
+	ParseInputsFromContext(ctx).AddImpure(MetadataDependency)
+
 	if dependencyConfig.shouldGetOutputs(ctx) {
 		outputVal, isEmpty, err := getTerragruntOutput(ctx, l, dependencyConfig)
 		if err != nil {
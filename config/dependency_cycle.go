@@ -0,0 +1,134 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// DependencyCycleEdge is an edge of a dependency cycle: the configuration at ConfigPath depends on the configuration
+// at DependencyPath, through the `dependency` block named DependencyName, or through the `dependencies` block if the
+// name is empty.
+type DependencyCycleEdge struct {
+	ConfigPath     string
+	DependencyPath string
+	DependencyName string
+	// IncludePaths are the paths of the files included by the configuration, in which the block can be defined.
+	IncludePaths []string
+}
+
+// DependencyCycleDiagnostics returns an error diagnostic for each edge of the dependency cycle, located at the block
+// creating the edge when it can be found. The detail of each diagnostic shows the whole cycle, given in order by the
+// edges.
+func DependencyCycleDiagnostics(edges []DependencyCycleEdge) diagnostic.Diagnostics {
+	if len(edges) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(edges)+1)
+	for _, edge := range edges {
+		paths = append(paths, edge.ConfigPath)
+	}
+
+	paths = append(paths, edges[len(edges)-1].DependencyPath)
+	cycle := strings.Join(paths, " -> ")
+
+	diags := make(diagnostic.Diagnostics, 0, len(edges))
+
+	for _, edge := range edges {
+		block := fmt.Sprintf("%s block", MetadataDependencies)
+		if edge.DependencyName != "" {
+			block = fmt.Sprintf("%s %q block", MetadataDependency, edge.DependencyName)
+		}
+
+		hclDiag := &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Dependency cycle",
+			Detail:   fmt.Sprintf("%s depends on %s through the %s, in the dependency cycle %s.", edge.ConfigPath, edge.DependencyPath, block, cycle),
+		}
+
+		file, rng := FindDependencyBlockRange(edge.ConfigPath, edge.IncludePaths, edge.DependencyName)
+		if rng != nil {
+			hclDiag.Subject = rng
+		}
+
+		diags = append(diags, diagnostic.NewDiagnostic(file, hclDiag))
+	}
+
+	return diags
+}
+
+// FindDependencyBlockRange returns the file and the range of the block making the configuration at configPath depend
+// on another configuration: the header of the `dependency` block with the given name, or the `paths` attribute of the
+// `dependencies` block if the name is empty. The block is searched in the configuration file first, then in the files
+// it includes. It returns nil if the block is not found.
+func FindDependencyBlockRange(configPath string, includePaths []string, name string) (*hcl.File, *hcl.Range) {
+	parser := hclparse.NewParser()
+
+	for _, path := range append([]string{configPath}, includePaths...) {
+		file := parseFileForRanges(parser, path)
+		if file == nil {
+			continue
+		}
+
+		if rng := findDependencyBlockRangeInBody(file.Body, name); rng != nil {
+			return file, rng
+		}
+	}
+
+	return nil, nil
+}
+
+// parseFileForRanges parses the HCL or JSON file at the path, ignoring the errors, as only the ranges of the blocks are
+// needed.
+func parseFileForRanges(parser *hclparse.Parser, path string) *hcl.File {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	if strings.HasSuffix(path, ".json") {
+		file, _ := parser.ParseJSON(content, path)
+		return file
+	}
+
+	file, _ := parser.ParseHCL(content, path)
+
+	return file
+}
+
+func findDependencyBlockRangeInBody(body hcl.Body, name string) *hcl.Range {
+	if body == nil {
+		return nil
+	}
+
+	content, _, _ := body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{
+			{Type: MetadataDependency, LabelNames: []string{"name"}},
+			{Type: MetadataDependencies},
+		},
+	})
+	if content == nil {
+		return nil
+	}
+
+	for _, block := range content.Blocks {
+		switch {
+		case name != "" && block.Type == MetadataDependency && len(block.Labels) == 1 && block.Labels[0] == name:
+			return block.DefRange.Ptr()
+		case name == "" && block.Type == MetadataDependencies:
+			attrs, _ := block.Body.JustAttributes()
+			if attr, ok := attrs["paths"]; ok {
+				return attr.Range.Ptr()
+			}
+
+			return block.DefRange.Ptr()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyCycleError(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		filepath.Join(tmpDir, "a", config.DefaultTerragruntConfigPath): `
+dependency "b" {
+  config_path = "../b"
+}
+`,
+		filepath.Join(tmpDir, "b", config.DefaultTerragruntConfigPath): `
+locals {
+  env = "dev"
+}
+
+dependency "c" {
+  config_path = "../c"
+}
+`,
+		filepath.Join(tmpDir, "c", config.DefaultTerragruntConfigPath): `
+dependency "a" {
+  config_path = "../a"
+}
+`,
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	configPath := filepath.Join(tmpDir, "a", config.DefaultTerragruntConfigPath)
+
+	l := logger.CreateLogger()
+	ctx := config.NewParsingContext(t.Context(), l, mockOptionsForTestWithConfigPath(t, configPath))
+
+	_, err := config.ParseConfigFile(ctx, l, configPath, nil)
+	require.Error(t, err)
+
+	var cycleErr config.DependencyCycleError
+	require.True(t, errors.As(err, &cycleErr), "expected a dependency cycle error, got %v", err)
+
+	paths := []string{
+		filepath.Join(tmpDir, "a", config.DefaultTerragruntConfigPath),
+		filepath.Join(tmpDir, "b", config.DefaultTerragruntConfigPath),
+		filepath.Join(tmpDir, "c", config.DefaultTerragruntConfigPath),
+		filepath.Join(tmpDir, "a", config.DefaultTerragruntConfigPath),
+	}
+	assert.Equal(t, paths, cycleErr.Paths)
+
+	require.Len(t, cycleErr.Diagnostics, 3)
+
+	for i, line := range []int{2, 6, 2} {
+		require.NotNil(t, cycleErr.Diagnostics[i].Range)
+		assert.Equal(t, paths[i], cycleErr.Diagnostics[i].Range.Filename)
+		assert.Equal(t, line, cycleErr.Diagnostics[i].Range.Start.Line)
+	}
+
+	assert.Contains(t, cycleErr.Error(), "Found a dependency cycle between modules: ")
+	assert.Contains(t, cycleErr.Error(), paths[1]+" -> "+paths[2]+" at "+paths[1]+":6,")
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
 )
 
 // Custom error types
@@ -267,8 +269,21 @@ https://terragrunt.gruntwork.io/docs/reference/config-blocks-and-attributes/#dep
 	)
 }
 
-type DependencyCycleError []string
+// DependencyCycleError is a cycle of dependency blocks between configurations, given by their paths in order, with the
+// first one repeated at the end. The diagnostics locate the blocks creating the edges of the cycle.
+type DependencyCycleError struct {
+	Paths       []string
+	Diagnostics diagnostic.Diagnostics
+}
 
 func (err DependencyCycleError) Error() string {
-	return "Found a dependency cycle between modules: " + strings.Join([]string(err), " -> ")
+	msg := "Found a dependency cycle between modules: " + strings.Join(err.Paths, " -> ")
+
+	for i, diag := range err.Diagnostics {
+		if diag.Range != nil && i+1 < len(err.Paths) {
+			msg += fmt.Sprintf("\n  %s -> %s at %s", err.Paths[i], err.Paths[i+1], diag.Range)
+		}
+	}
+
+	return msg
 }
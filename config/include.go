@@ -184,7 +184,7 @@ func handleIncludeForDependency(ctx *ParsingContext, l log.Logger, childDecodedD
 		}
 
 		includedPartialParse, err := partialParseIncludedConfig(
-			ctx.WithDecodeList(DependencyBlock, FeatureFlagsBlock, ExcludeBlock, ErrorsBlock), l, &includeConfig)
+			ctx.WithDecodeList(DependencyBlock, FeatureFlagsBlock, ExcludeBlock, ErrorsBlock, ConcurrencyBlock), l, &includeConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -250,6 +250,10 @@ func (cfg *TerragruntConfig) Merge(l log.Logger, sourceConfig *TerragruntConfig,
 		cfg.PreventDestroy = sourceConfig.PreventDestroy
 	}
 
+	if sourceConfig.Timeout != nil {
+		cfg.Timeout = sourceConfig.Timeout
+	}
+
 	if sourceConfig.RetryMaxAttempts != nil {
 		cfg.RetryMaxAttempts = sourceConfig.RetryMaxAttempts
 	}
@@ -278,6 +282,10 @@ func (cfg *TerragruntConfig) Merge(l log.Logger, sourceConfig *TerragruntConfig,
 		cfg.Errors = sourceConfig.Errors.Clone()
 	}
 
+	if sourceConfig.Concurrency != nil {
+		cfg.Concurrency = sourceConfig.Concurrency.Clone()
+	}
+
 	if sourceConfig.RemoteState != nil {
 		cfg.RemoteState = sourceConfig.RemoteState
 	}
@@ -380,6 +388,10 @@ func (cfg *TerragruntConfig) DeepMerge(l log.Logger, sourceConfig *TerragruntCon
 		cfg.PreventDestroy = sourceConfig.PreventDestroy
 	}
 
+	if sourceConfig.Timeout != nil {
+		cfg.Timeout = sourceConfig.Timeout
+	}
+
 	if sourceConfig.RetryMaxAttempts != nil {
 		cfg.RetryMaxAttempts = sourceConfig.RetryMaxAttempts
 	}
@@ -408,6 +420,14 @@ func (cfg *TerragruntConfig) DeepMerge(l log.Logger, sourceConfig *TerragruntCon
 		cfg.Exclude.Merge(sourceConfig.Exclude)
 	}
 
+	if sourceConfig.Concurrency != nil {
+		if cfg.Concurrency == nil {
+			cfg.Concurrency = &ConcurrencyConfig{}
+		}
+
+		cfg.Concurrency.Merge(sourceConfig.Concurrency)
+	}
+
 	if sourceConfig.Errors != nil {
 		if cfg.Errors == nil {
 			cfg.Errors = &ErrorsConfig{}
@@ -0,0 +1,235 @@
+package config
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// pathFunctionNames are the names of the HCL functions that read the file at the path given as the first argument.
+var pathFunctionNames = []string{
+	"file",
+	"fileexists",
+	"filebase64",
+	"filemd5",
+	"filesha1",
+	"filesha256",
+	"filesha512",
+	"filebase64sha256",
+	"filebase64sha512",
+}
+
+// impureFunctionNames are the names of the HCL functions whose results depend on more than the files and the
+// environment variables recorded by ParseInputs, like the current time, the output of commands or remote APIs.
+var impureFunctionNames = []string{
+	FuncNameRunCmd,
+	FuncNameGetRepoRoot,
+	FuncNameGetPathFromRepoRoot,
+	FuncNameGetPathToRepoRoot,
+	FuncNameGetOriginalTerragruntDir,
+	FuncNameGetTerraformCommand,
+	FuncNameGetTerraformCLIArgs,
+	FuncNameGetAWSAccountAlias,
+	FuncNameGetAWSAccountID,
+	FuncNameGetAWSCallerIdentityArn,
+	FuncNameGetAWSCallerIdentityUserID,
+	FuncNameSopsDecryptFile,
+	FuncNameGetTerragruntSourceCLIFlag,
+	FuncNameGetWorkingDir,
+	"abspath",
+	"bcrypt",
+	"fileset",
+	"pathexpand",
+	"templatefile",
+	"timestamp",
+	"uuid",
+}
+
+// ReadFile is a file read by a unit with an HCL function, as recorded in `TerragruntOptions.ReadFiles`.
+type ReadFile struct {
+	Path string `json:"path"`
+	Unit string `json:"unit"`
+}
+
+// ParseInputs records the inputs a parse of a configuration depends on: the files it reads, including the files
+// looked up that don't exist, and the environment variables it reads. A parse that calls an impure function, like
+// `run_cmd`, depends on more than that, and is recorded as impure.
+//
+// The result of a pure parse is the same as long as the recorded files and environment variables don't change, so
+// it can be cached.
+type ParseInputs struct {
+	files     map[string]struct{}
+	env       map[string]struct{}
+	impure    map[string]struct{}
+	readFiles []ReadFile
+	mu        sync.Mutex
+}
+
+// NewParseInputs returns an empty record of parse inputs.
+func NewParseInputs() *ParseInputs {
+	return &ParseInputs{
+		files:  make(map[string]struct{}),
+		env:    make(map[string]struct{}),
+		impure: make(map[string]struct{}),
+	}
+}
+
+// ContextWithParseInputs returns a context in which the parses of configurations record their inputs in `inputs`.
+func ContextWithParseInputs(ctx context.Context, inputs *ParseInputs) context.Context {
+	return context.WithValue(ctx, ParseInputsContextKey, inputs)
+}
+
+// ParseInputsFromContext returns the record of parse inputs of the context, or nil if the inputs are not recorded.
+func ParseInputsFromContext(ctx context.Context) *ParseInputs {
+	if inputs, ok := ctx.Value(ParseInputsContextKey).(*ParseInputs); ok {
+		return inputs
+	}
+
+	return nil
+}
+
+// AddFile records that the parse depends on the content, or the absence, of the file.
+func (inputs *ParseInputs) AddFile(path string) {
+	if inputs == nil {
+		return
+	}
+
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	inputs.files[filepath.Clean(path)] = struct{}{}
+}
+
+// AddReadFile records that the unit read the file with an HCL function, which the parse depends on.
+func (inputs *ParseInputs) AddReadFile(path, unit string) {
+	if inputs == nil {
+		return
+	}
+
+	inputs.AddFile(path)
+
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	readFile := ReadFile{Path: path, Unit: unit}
+	if !slices.Contains(inputs.readFiles, readFile) {
+		inputs.readFiles = append(inputs.readFiles, readFile)
+	}
+}
+
+// AddEnv records that the parse depends on the value, or the absence, of the environment variable.
+func (inputs *ParseInputs) AddEnv(name string) {
+	if inputs == nil {
+		return
+	}
+
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	inputs.env[name] = struct{}{}
+}
+
+// AddImpure records that the parse called the impure function with the given name.
+func (inputs *ParseInputs) AddImpure(name string) {
+	if inputs == nil {
+		return
+	}
+
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	inputs.impure[name] = struct{}{}
+}
+
+// Files returns the files the parse depends on, in alphabetical order.
+func (inputs *ParseInputs) Files() []string {
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(inputs.files))
+}
+
+// ReadFiles returns the files read by the units with HCL functions during the parse.
+func (inputs *ParseInputs) ReadFiles() []ReadFile {
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	return slices.Clone(inputs.readFiles)
+}
+
+// Env returns the names of the environment variables the parse depends on, in alphabetical order.
+func (inputs *ParseInputs) Env() []string {
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(inputs.env))
+}
+
+// Impure returns the names of the impure functions called during the parse, in alphabetical order.
+func (inputs *ParseInputs) Impure() []string {
+	inputs.mu.Lock()
+	defer inputs.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(inputs.impure))
+}
+
+// wrapFunctionsWithParseInputs wraps the HCL functions that read files, and the impure ones, to record them in the
+// parse inputs of the context. The functions are left as is if the inputs are not recorded.
+func wrapFunctionsWithParseInputs(ctx context.Context, functions map[string]function.Function, baseDir string) {
+	inputs := ParseInputsFromContext(ctx)
+	if inputs == nil {
+		return
+	}
+
+	for _, name := range pathFunctionNames {
+		if fn, ok := functions[name]; ok {
+			functions[name] = wrapFunction(fn, func(args []cty.Value) {
+				if len(args) == 0 || !args[0].IsKnown() || args[0].IsNull() || args[0].Type() != cty.String {
+					inputs.AddImpure(name)
+					return
+				}
+
+				path := args[0].AsString()
+
+				switch {
+				case strings.HasPrefix(path, "~"):
+					inputs.AddImpure(name)
+				case filepath.IsAbs(path):
+					inputs.AddFile(path)
+				default:
+					inputs.AddFile(filepath.Join(baseDir, path))
+				}
+			})
+		}
+	}
+
+	for _, name := range impureFunctionNames {
+		if fn, ok := functions[name]; ok {
+			functions[name] = wrapFunction(fn, func([]cty.Value) {
+				inputs.AddImpure(name)
+			})
+		}
+	}
+}
+
+// wrapFunction returns the function calling `record` with the arguments before each call.
+func wrapFunction(fn function.Function, record func(args []cty.Value)) function.Function {
+	return function.New(&function.Spec{
+		Params:   fn.Params(),
+		VarParam: fn.VarParam(),
+		Type: func(args []cty.Value) (cty.Type, error) {
+			return fn.ReturnTypeForValues(args)
+		},
+		Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+			record(args)
+
+			return fn.Call(args)
+		},
+	})
+}
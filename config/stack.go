@@ -810,6 +810,8 @@ func ReadValues(ctx context.Context, l log.Logger, opts *options.TerragruntOptio
 
 	filePath := filepath.Join(directory, valuesFile)
 
+	ParseInputsFromContext(ctx).AddFile(filePath)
+
 	if util.FileNotExists(filePath) {
 		return nil, nil
 	}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// ParseTimeout parses the `timeout` attribute, a Go duration string like `30m` or `1h30m`.
+func ParseTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Errorf("the `%s` attribute must be a duration like \"30m\" or \"1h30m\", got %q", MetadataTimeout, value)
+	}
+
+	if timeout <= 0 {
+		return 0, errors.Errorf("the `%s` attribute must be positive, got %q", MetadataTimeout, value)
+	}
+
+	return timeout, nil
+}
+
+// TimeoutDuration returns the duration of the `timeout` attribute, or 0 if it is not set.
+func (cfg *TerragruntConfig) TimeoutDuration() time.Duration {
+	if cfg == nil || cfg.Timeout == nil {
+		return 0
+	}
+
+	// The attribute is validated when the configuration is parsed.
+	timeout, _ := ParseTimeout(*cfg.Timeout)
+
+	return timeout
+}
+
+// TimeoutError is returned when a unit runs longer than its `timeout` attribute.
+type TimeoutError struct {
+	Err     error
+	Timeout time.Duration
+}
+
+func (err TimeoutError) Error() string {
+	if err.Err == nil {
+		return fmt.Sprintf("unit timed out after %s", err.Timeout)
+	}
+
+	return fmt.Sprintf("unit timed out after %s: %v", err.Timeout, err.Err)
+}
+
+func (err TimeoutError) Unwrap() error {
+	return err.Err
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/parsecache"
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/util"
@@ -442,12 +443,31 @@ func (stack *DefaultStack) ResolveTerraformModules(ctx context.Context, l log.Lo
 		return nil, err
 	}
 
+	var withUnitsChanged TerraformModules
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "flag_units_changed_since", map[string]any{
+		"working_dir": stack.terragruntOptions.WorkingDir,
+	}, func(ctx context.Context) error {
+		result, err := withUnitsRead.flagUnitsChangedSince(ctx, l, stack.terragruntOptions)
+		if err != nil {
+			return err
+		}
+
+		withUnitsChanged = result
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
 	var withModulesExcluded TerraformModules
 
 	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "flag_excluded_dirs", map[string]any{
 		"working_dir": stack.terragruntOptions.WorkingDir,
 	}, func(_ context.Context) error {
-		withModulesExcluded = withUnitsRead.flagExcludedDirs(l, stack.terragruntOptions, stack.report)
+		withModulesExcluded = withUnitsChanged.flagExcludedDirs(l, stack.terragruntOptions, stack.report)
 		return nil
 	})
 
@@ -455,7 +475,59 @@ func (stack *DefaultStack) ResolveTerraformModules(ctx context.Context, l log.Lo
 		return nil, err
 	}
 
-	return withModulesExcluded, nil
+	var withUnitsFiltered TerraformModules
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "flag_units_not_matching_filters", map[string]any{
+		"working_dir": stack.terragruntOptions.WorkingDir,
+	}, func(_ context.Context) error {
+		result, err := withModulesExcluded.flagUnitsNotMatchingFilters(l, stack.terragruntOptions, stack.report)
+		if err != nil {
+			return err
+		}
+
+		withUnitsFiltered = result
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var withUnitsInShard TerraformModules
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "flag_units_outside_shard", map[string]any{
+		"working_dir": stack.terragruntOptions.WorkingDir,
+	}, func(_ context.Context) error {
+		result, err := withUnitsFiltered.flagUnitsOutsideShard(l, stack.terragruntOptions, stack.report)
+		if err != nil {
+			return err
+		}
+
+		withUnitsInShard = result
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "flag_units_resumed", map[string]any{
+		"working_dir": stack.terragruntOptions.WorkingDir,
+	}, func(ctx context.Context) error {
+		if err := withUnitsInShard.fingerprintUnits(stack.terragruntOptions); err != nil {
+			return err
+		}
+
+		return withUnitsInShard.flagUnitsResumed(ctx, l, stack.terragruntOptions)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return withUnitsInShard, nil
 }
 
 // Go through each of the given Terragrunt configuration files and resolve the module that configuration file represents
@@ -571,6 +643,7 @@ func (stack *DefaultStack) resolveTerraformModule(ctx context.Context, l log.Log
 			config.DependencyBlock,
 			config.FeatureFlagsBlock,
 			config.ErrorsBlock,
+			config.ConcurrencyBlock,
 		)
 
 	// Credentials have to be acquired before the config is parsed, as the config may contain interpolation functions
@@ -585,12 +658,9 @@ func (stack *DefaultStack) resolveTerraformModule(ctx context.Context, l log.Log
 	// before we call out to terraform.
 
 	// TODO: Remove lint suppression
-	terragruntConfig, err := config.PartialParseConfigFile( //nolint:contextcheck
-		parseCtx,
-		l,
-		terragruntConfigPath,
-		includeConfig,
-	)
+	terragruntConfig, err := parsecache.FromOptions(l, opts).Parse(parseCtx, l, terragruntConfigPath, includeConfig, func(ctx *config.ParsingContext) (*config.TerragruntConfig, error) {
+		return config.PartialParseConfigFile(ctx, l, terragruntConfigPath, includeConfig) //nolint:contextcheck
+	})
 	if err != nil {
 		return nil, errors.New(ProcessingModuleError{
 			UnderlyingError:       err,
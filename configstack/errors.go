@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/util"
 )
@@ -73,6 +74,25 @@ func (err ProcessingModuleDependencyError) Unwrap() error {
 	return err.Err
 }
 
+// QueueTimeoutError is returned for the units that were stopped, or did not start, because the run took longer than
+// the `--queue-timeout`.
+type QueueTimeoutError struct {
+	Err     error
+	Timeout time.Duration
+}
+
+func (err QueueTimeoutError) Error() string {
+	if err.Err == nil {
+		return fmt.Sprintf("run timed out after %s", err.Timeout)
+	}
+
+	return fmt.Sprintf("run timed out after %s: %v", err.Timeout, err.Err)
+}
+
+func (err QueueTimeoutError) Unwrap() error {
+	return err.Err
+}
+
 type DependencyNotFoundWhileCrossLinkingError struct {
 	Module     *RunningModule
 	Dependency *TerraformModule
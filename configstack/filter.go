@@ -0,0 +1,57 @@
+package configstack
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// flagUnitsNotMatchingFilters flags the modules that will run as excluded unless they match at least one of the
+// filter expressions in the TerragruntOptions QueueFilters attribute.
+func (modules TerraformModules) flagUnitsNotMatchingFilters(l log.Logger, opts *options.TerragruntOptions, r *report.Report) (TerraformModules, error) {
+	if len(opts.QueueFilters) == 0 {
+		return modules, nil
+	}
+
+	filters, err := filter.ParseAll(opts.QueueFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make(map[string]bool)
+
+	for _, cfg := range filters.Select(opts, modules.toDiscoveredConfigs()) {
+		matching[cfg.Path] = true
+	}
+
+	for _, module := range modules {
+		if module.FlagExcluded || module.AssumeAlreadyApplied || matching[module.Path] {
+			continue
+		}
+
+		l.Debugf("Unit %s is excluded, as it does not match any of the filters", module.Path)
+
+		module.FlagExcluded = true
+
+		if !opts.Experiments.Evaluate(experiment.Report) {
+			continue
+		}
+
+		run, err := report.NewRun(module.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.AddRun(run); err != nil {
+			return nil, err
+		}
+
+		if err := r.EndRun(run.Path, report.WithResult(report.ResultExcluded), report.WithReason(report.ReasonQueueFilter)); err != nil {
+			return nil, err
+		}
+	}
+
+	return modules, nil
+}
@@ -37,10 +37,13 @@ type TerraformModule struct {
 	TerragruntOptions    *options.TerragruntOptions
 	Logger               log.Logger
 	Path                 string
+	Fingerprint          string
 	Dependencies         TerraformModules
 	Config               config.TerragruntConfig
+	Selection            *report.Selection
 	AssumeAlreadyApplied bool
 	FlagExcluded         bool
+	Resumed              bool
 }
 
 // String renders this module as a human-readable string
@@ -558,6 +561,159 @@ func (modules TerraformModules) flagUnitsThatRead(opts *options.TerragruntOption
 	return modules
 }
 
+// flagUnitsChangedSince iterates over a module slice and flags all modules affected by the files changed between the
+// git ref in the TerragruntOptions ChangedSince attribute and the working tree, or by the files in the ChangedFiles
+// attribute.
+func (modules TerraformModules) flagUnitsChangedSince(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (TerraformModules, error) {
+	// If no ChangedSince or ChangedFiles is specified return the modules list instantly
+	if opts.ChangedSince == "" && len(opts.ChangedFiles) == 0 {
+		return modules, nil
+	}
+
+	changedFiles := slices.Clone(opts.ChangedFiles)
+
+	if opts.ChangedSince == "" {
+		return modules.flagChangedUnits(l, opts, changedFiles), nil
+	}
+
+	changes, err := shell.GitDiffNameStatus(ctx, l, opts, opts.WorkingDir, opts.ChangedSince)
+	if err != nil {
+		return nil, errors.Errorf("failed to list the files changed since %s: %w", opts.ChangedSince, err)
+	}
+
+	for _, change := range changes {
+		changedFiles = append(changedFiles, change.Path)
+
+		if change.OldPath != "" {
+			changedFiles = append(changedFiles, change.OldPath)
+		}
+	}
+
+	return modules.flagChangedUnits(l, opts, changedFiles), nil
+}
+
+// flagChangedUnits flags all modules affected by the given changed files, and records why each of them was selected.
+// A module is affected if a file in its directory changed, if a file it includes or reads changed, or if a file of its
+// local `terraform.source` module changed. The dependents of the affected modules are also flagged if the
+// TerragruntOptions IncludeChangedDependents attribute is set.
+func (modules TerraformModules) flagChangedUnits(l log.Logger, opts *options.TerragruntOptions, changedFiles []string) TerraformModules {
+	for _, file := range changedFiles {
+		// A file under a nested unit only belongs to the nested unit.
+		var owner *TerraformModule
+
+		for _, module := range modules {
+			if util.HasPathPrefix(file, module.Path) && (owner == nil || len(module.Path) > len(owner.Path)) {
+				owner = module
+			}
+		}
+
+		if owner != nil {
+			owner.selectChanged(l, report.SelectionChangedDir, file)
+		}
+
+		for _, module := range modules {
+			if module.didReadFile(opts, file) {
+				module.selectChanged(l, report.SelectionChangedFileRead, file)
+			}
+
+			if sourceDir := module.localSourceDir(); sourceDir != "" && util.HasPathPrefix(file, sourceDir) {
+				module.selectChanged(l, report.SelectionChangedSource, file)
+			}
+		}
+	}
+
+	if !opts.IncludeChangedDependents {
+		return modules
+	}
+
+	// Keep flagging the dependents until no new module is flagged, so the dependents of dependents are flagged too.
+	for {
+		flagged := false
+
+		for _, module := range modules {
+			if module.Selection != nil {
+				continue
+			}
+
+			for _, dependency := range module.Dependencies {
+				if dependency.Selection != nil {
+					module.selectChanged(l, report.SelectionChangedDependency, dependency.Path)
+					flagged = true
+
+					break
+				}
+			}
+		}
+
+		if !flagged {
+			return modules
+		}
+	}
+}
+
+// selectChanged flags the module as included, and records why it was selected unless it was already selected.
+func (module *TerraformModule) selectChanged(l log.Logger, reason report.SelectionReason, cause string) {
+	module.FlagExcluded = false
+
+	if module.Selection != nil {
+		return
+	}
+
+	l.Debugf("Unit %s is selected: %s %s", module.Path, reason, cause)
+
+	module.Selection = &report.Selection{Reason: reason, Cause: cause}
+}
+
+// didReadFile returns true if the module includes the given file, or reads it using an HCL function.
+func (module *TerraformModule) didReadFile(opts *options.TerragruntOptions, file string) bool {
+	for _, includeConfig := range module.Config.ProcessedIncludes {
+		if path, err := util.CanonicalPath(includeConfig.Path, module.Path); err == nil && path == file {
+			return true
+		}
+	}
+
+	return opts.DidReadFile(file, module.Path)
+}
+
+// localSourceDir returns the directory of the module in `terraform.source`, if it is on the local file system.
+func (module *TerraformModule) localSourceDir() string {
+	if module.Config.Terraform == nil || module.Config.Terraform.Source == nil {
+		return ""
+	}
+
+	sourceURL, err := tf.ToSourceURL(*module.Config.Terraform.Source, module.Path)
+	if err != nil || !tf.IsLocalSource(sourceURL) {
+		return ""
+	}
+
+	// The `//` separating the root of the source from the module directory does not change the module directory.
+	return filepath.Clean(filepath.FromSlash(strings.Replace(sourceURL.Path, "//", "/", 1)))
+}
+
+// WatchedPaths returns the paths the modules that will run depend on, to watch them for changes: the directories of
+// the modules, the files they include or read using HCL functions, and the directories of their local
+// `terraform.source` modules.
+func (modules TerraformModules) WatchedPaths(opts *options.TerragruntOptions) []string {
+	var paths []string
+
+	for _, module := range modules {
+		if module.FlagExcluded {
+			continue
+		}
+
+		paths = append(paths, module.Path)
+		paths = append(paths, module.readFiles(opts)...)
+
+		if sourceDir := module.localSourceDir(); sourceDir != "" {
+			paths = append(paths, sourceDir)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return slices.Compact(paths)
+}
+
 // flagExcludedDirs iterates over a module slice and flags all entries as excluded listed in the queue-exclude-dir CLI flag.
 func (modules TerraformModules) flagExcludedDirs(l log.Logger, opts *options.TerragruntOptions, r *report.Report) TerraformModules {
 	// If we don't have any excludes, we don't need to do anything.
@@ -2,12 +2,19 @@ package configstack_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/log/format"
 	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
 	"github.com/gruntwork-io/terragrunt/util"
 
@@ -1462,3 +1469,173 @@ func TestRunModulesReverseOrderMultipleModulesWithDependenciesLargeGraphPartialF
 	assert.True(t, eRan)
 	assert.True(t, fRan)
 }
+
+func TestRunModulesConcurrencyGroupLimit(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+
+	group, limit := "database", 1
+
+	runTerragrunt, maxRunning := trackMaxRunning()
+
+	modules := configstack.TerraformModules{}
+
+	for _, path := range []string{"a", "b", "c"} {
+		opts, err := options.NewTerragruntOptionsForTest(path)
+		require.NoError(t, err)
+
+		opts.RunTerragrunt = runTerragrunt
+
+		modules = append(modules, &configstack.TerraformModule{
+			Stack:        &configstack.DefaultStack{},
+			Path:         path,
+			Dependencies: configstack.TerraformModules{},
+			Config: config.TerragruntConfig{
+				Concurrency: &config.ConcurrencyConfig{Group: &group, Limit: &limit},
+			},
+			Logger:            l,
+			TerragruntOptions: opts,
+		})
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	err = modules.RunModules(t.Context(), opts, report.NewReport(), options.DefaultParallelism)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), maxRunning())
+}
+
+func TestRunModulesConcurrencyGroupConflictingLimits(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+
+	formatter := format.NewFormatter(format.NewKeyValueFormatPlaceholders())
+	formatter.SetDisabledColors(true)
+
+	l := log.New(log.WithOutput(&stdout), log.WithLevel(log.DebugLevel), log.WithFormatter(formatter))
+
+	group := "database"
+
+	runTerragrunt, maxRunning := trackMaxRunning()
+
+	modules := configstack.TerraformModules{}
+
+	for path, limit := range map[string]int{"a": 2, "b": 1, "c": 2} {
+		opts, err := options.NewTerragruntOptionsForTest(path)
+		require.NoError(t, err)
+
+		opts.RunTerragrunt = runTerragrunt
+
+		modules = append(modules, &configstack.TerraformModule{
+			Stack:        &configstack.DefaultStack{},
+			Path:         path,
+			Dependencies: configstack.TerraformModules{},
+			Config: config.TerragruntConfig{
+				Concurrency: &config.ConcurrencyConfig{Group: &group, Limit: &limit},
+			},
+			Logger:            l,
+			TerragruntOptions: opts,
+		})
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	err = modules.RunModules(t.Context(), opts, report.NewReport(), options.DefaultParallelism)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), maxRunning())
+	assert.Contains(t, stdout.String(), "Module b sets limit 1 for concurrency group database, but module a sets limit 2. The lowest limit 1 applies.")
+	assert.Contains(t, stdout.String(), "Module c sets limit 2 for concurrency group database, but module b sets limit 1. The lowest limit 1 applies.")
+}
+
+// trackMaxRunning returns a RunTerragrunt func that takes a while to run, and a getter for the highest number
+// of its calls that were running at the same time.
+func trackMaxRunning() (func(context.Context, log.Logger, *options.TerragruntOptions, *report.Report) error, func() int32) {
+	var running, maxRunning atomic.Int32
+
+	runTerragrunt := func(_ context.Context, _ log.Logger, _ *options.TerragruntOptions, _ *report.Report) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for highest := maxRunning.Load(); current > highest && !maxRunning.CompareAndSwap(highest, current); {
+			highest = maxRunning.Load()
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		return nil
+	}
+
+	return runTerragrunt, maxRunning.Load
+}
+
+func TestRunModulesQueueTimeout(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+	tmpDir := t.TempDir()
+
+	newModule := func(name string, dependencies configstack.TerraformModules) *configstack.TerraformModule {
+		opts, err := options.NewTerragruntOptionsForTest(filepath.Join(tmpDir, name))
+		require.NoError(t, err)
+		require.NoError(t, opts.Experiments.EnableExperiment(experiment.Report))
+
+		opts.RunTerragrunt = func(ctx context.Context, _ log.Logger, _ *options.TerragruntOptions, _ *report.Report) error {
+			<-ctx.Done()
+
+			return ctx.Err()
+		}
+
+		return &configstack.TerraformModule{
+			Stack:             &configstack.DefaultStack{},
+			Path:              filepath.Join(tmpDir, name),
+			Dependencies:      dependencies,
+			Logger:            l,
+			TerragruntOptions: opts,
+		}
+	}
+
+	moduleA := newModule("a", configstack.TerraformModules{})
+	moduleB := newModule("b", configstack.TerraformModules{moduleA})
+	moduleC := newModule("c", configstack.TerraformModules{})
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+	require.NoError(t, opts.Experiments.EnableExperiment(experiment.Report))
+
+	opts.QueueTimeout = 100 * time.Millisecond
+
+	r := report.NewReport()
+
+	// With a parallelism of 1, only one of the independent modules a and c starts before the timeout.
+	err = configstack.TerraformModules{moduleA, moduleB, moduleC}.RunModules(t.Context(), opts, r, 1)
+	require.Error(t, err)
+
+	timeoutErr := new(configstack.QueueTimeoutError)
+	require.ErrorAs(t, err, timeoutErr)
+	assert.Equal(t, opts.QueueTimeout, timeoutErr.Timeout)
+
+	var results []report.Result
+
+	for _, module := range []*configstack.TerraformModule{moduleA, moduleC} {
+		run, err := r.GetRun(module.Path)
+		require.NoError(t, err)
+		require.NotNil(t, run.Reason)
+		assert.Equal(t, report.ReasonTimeout, *run.Reason)
+
+		results = append(results, run.Result)
+	}
+
+	assert.ElementsMatch(t, []report.Result{report.ResultFailed, report.ResultEarlyExit}, results)
+
+	runB, err := r.GetRun(moduleB.Path)
+	require.NoError(t, err)
+	assert.Equal(t, report.ResultEarlyExit, runB.Result)
+	require.NotNil(t, runB.Reason)
+	assert.Equal(t, report.ReasonAncestorError, *runB.Reason)
+}
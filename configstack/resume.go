@@ -0,0 +1,269 @@
+package configstack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/codegen"
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+// fingerprintUnits records the fingerprint of the modules that will run, so it can be written to the run report, and
+// compared with the fingerprints of a previous run report when resuming from it.
+func (modules TerraformModules) fingerprintUnits(opts *options.TerragruntOptions) error {
+	if !opts.Experiments.Evaluate(experiment.Report) && opts.ResumeFrom == "" {
+		return nil
+	}
+
+	for _, module := range modules {
+		if module.FlagExcluded || module.AssumeAlreadyApplied {
+			continue
+		}
+
+		fingerprint, err := module.fingerprint(opts)
+		if err != nil {
+			return errors.Errorf("failed to fingerprint unit %s: %w", module.Path, err)
+		}
+
+		module.Fingerprint = fingerprint
+	}
+
+	return nil
+}
+
+// flagUnitsResumed flags the modules that succeeded in the run report in the TerragruntOptions ResumeFrom attribute
+// as already applied, so only the modules that failed, exited early, or did not run are run again. Returns an error
+// if the fingerprint of any of the modules that succeeded changed since the report, including their evaluated inputs,
+// as skipping them would leave their changes unapplied.
+func (modules TerraformModules) flagUnitsResumed(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	if opts.ResumeFrom == "" {
+		return nil
+	}
+
+	reportFile := opts.ResumeFrom
+	if !filepath.IsAbs(reportFile) {
+		reportFile = filepath.Join(opts.WorkingDir, reportFile)
+	}
+
+	runs, err := report.ReadJSONFile(reportFile)
+	if err != nil {
+		return errors.Errorf("failed to resume from %s: %w", opts.ResumeFrom, err)
+	}
+
+	previousRuns := make(map[string]report.JSONRun, len(runs))
+	for _, run := range runs {
+		previousRuns[run.Name] = run
+	}
+
+	var (
+		changed []string
+		resumed int
+	)
+
+	for _, module := range modules {
+		if module.FlagExcluded || module.AssumeAlreadyApplied {
+			continue
+		}
+
+		name := report.NameOfPath(module.Path, opts.WorkingDir)
+
+		run, ok := previousRuns[name]
+		if !ok || run.Result != string(report.ResultSucceeded) {
+			continue
+		}
+
+		fingerprint, err := module.fingerprintInputs(ctx, l)
+		if err != nil {
+			return errors.Errorf("cannot resume from %s: %w", opts.ResumeFrom, err)
+		}
+
+		if run.Fingerprint == nil || *run.Fingerprint != fingerprint {
+			changed = append(changed, name)
+
+			continue
+		}
+
+		l.Debugf("Unit %s already succeeded in %s, skipping it", module.Path, opts.ResumeFrom)
+
+		module.Fingerprint = fingerprint
+		module.AssumeAlreadyApplied = true
+		module.Resumed = true
+		resumed++
+	}
+
+	if len(changed) > 0 {
+		slices.Sort(changed)
+
+		return errors.Errorf("cannot resume from %s, the command, configuration, inputs or sources of units that already succeeded changed since the report: %s", opts.ResumeFrom, strings.Join(changed, ", "))
+	}
+
+	l.Infof("Resuming from %s, skipping %d unit(s) that already succeeded", opts.ResumeFrom, resumed)
+
+	return nil
+}
+
+// fingerprint returns a hash of the command, configuration and sources of the module: the files in the directory of
+// the module, the files it includes or reads using HCL functions, and the files of its local `terraform.source`
+// module, or the URL of its remote one. Hidden files, state files and files generated by Terragrunt are ignored,
+// as running the module changes them.
+func (module *TerraformModule) fingerprint(opts *options.TerragruntOptions) (string, error) {
+	fingerprint := sha256.New()
+
+	fmt.Fprintf(fingerprint, "command:%s\n", opts.TerraformCommand)
+
+	if err := fingerprintDir(fingerprint, "unit", module.Path, true); err != nil {
+		return "", err
+	}
+
+	for _, file := range module.readFiles(opts) {
+		if err := fingerprintFile(fingerprint, "read", module.Path, file); err != nil {
+			return "", err
+		}
+	}
+
+	if sourceDir := module.localSourceDir(); sourceDir != "" {
+		if err := fingerprintDir(fingerprint, "source", sourceDir, false); err != nil {
+			return "", err
+		}
+	} else if module.Config.Terraform != nil && module.Config.Terraform.Source != nil {
+		fmt.Fprintf(fingerprint, "source:%s\n", *module.Config.Terraform.Source)
+	}
+
+	return hex.EncodeToString(fingerprint.Sum(nil)), nil
+}
+
+// fingerprintInputs returns the fingerprint of the module with its inputs, evaluated by fully parsing its
+// configuration. The inputs can change without any file of the module changing, like the outputs of its dependencies,
+// or the results of `get_env` or `run_cmd`. As the outputs of the dependencies are only known once they ran, the
+// inputs are only added to the fingerprint of the module once it succeeded, and when resuming from a run report.
+func (module *TerraformModule) fingerprintInputs(ctx context.Context, l log.Logger) (string, error) {
+	l, opts, err := module.TerragruntOptions.CloneWithConfigPath(l, module.TerragruntOptions.TerragruntConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := config.ParseConfigFile(config.NewParsingContext(ctx, l, opts), l, opts.TerragruntConfigPath, nil)
+	if err != nil {
+		return "", errors.Errorf("failed to evaluate the inputs of unit %s: %w", module.Path, err)
+	}
+
+	// The keys of the maps are sorted by JSON encoding, so equal inputs have the same encoding.
+	inputs, err := json.Marshal(cfg.Inputs)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	fingerprint := sha256.New()
+
+	fmt.Fprintf(fingerprint, "unit:%s\n", module.Fingerprint)
+	fmt.Fprintf(fingerprint, "inputs:%s\n", inputs)
+
+	return hex.EncodeToString(fingerprint.Sum(nil)), nil
+}
+
+// readFiles returns the files the module includes, or reads using HCL functions.
+func (module *TerraformModule) readFiles(opts *options.TerragruntOptions) []string {
+	var files []string
+
+	for _, includeConfig := range module.Config.ProcessedIncludes {
+		if path, err := util.CanonicalPath(includeConfig.Path, module.Path); err == nil {
+			files = append(files, path)
+		}
+	}
+
+	if opts.ReadFiles != nil {
+		opts.ReadFiles.Range(func(file string, units []string) bool {
+			if slices.Contains(units, module.Path) {
+				files = append(files, file)
+			}
+
+			return true
+		})
+	}
+
+	slices.Sort(files)
+
+	return slices.Compact(files)
+}
+
+// fingerprintDir adds the files of the given directory to the fingerprint. If skipUnits is set, the directories of
+// nested units are skipped, as they have their own fingerprint.
+func fingerprintDir(fingerprint hash.Hash, label, dir string, skipUnits bool) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		if entry.IsDir() {
+			if strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			if skipUnits && util.FileExists(filepath.Join(path, config.DefaultTerragruntConfigPath)) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") || isStateFile(entry.Name()) {
+			return nil
+		}
+
+		return fingerprintFile(fingerprint, label, dir, path)
+	})
+}
+
+// fingerprintFile adds the path of the given file, relative to baseDir, and the hash of its content to the
+// fingerprint. Files generated by Terragrunt are skipped, and missing files are recorded as such.
+func fingerprintFile(fingerprint hash.Hash, label, baseDir, path string) error {
+	relPath, err := filepath.Rel(baseDir, path)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	relPath = filepath.ToSlash(relPath)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(fingerprint, "%s:%s:missing\n", label, relPath)
+
+		return nil
+	}
+
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if firstLine, _, _ := strings.Cut(string(data), "\n"); strings.HasSuffix(strings.TrimSpace(firstLine), codegen.TerragruntGeneratedSignature) {
+		return nil
+	}
+
+	fmt.Fprintf(fingerprint, "%s:%s:%x\n", label, relPath, sha256.Sum256(data))
+
+	return nil
+}
+
+// isStateFile returns true if the given file name is a state file, or a backup of one, written by the local backend.
+func isStateFile(name string) bool {
+	return strings.HasSuffix(name, ".tfstate") || strings.HasSuffix(name, ".tfstate.backup")
+}
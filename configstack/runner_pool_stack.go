@@ -187,6 +187,13 @@ func (stack *RunnerPoolStack) Run(ctx context.Context, l log.Logger, opts *optio
 		defer stack.summarizePlanAllErrors(l, errorStreams)
 	}
 
+	if opts.QueueTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.QueueTimeout, QueueTimeoutError{Timeout: opts.QueueTimeout})
+		defer cancel()
+	}
+
 	var errs []error
 
 	// Run each module in the stack sequentially, convert each module to a running module, and run it.
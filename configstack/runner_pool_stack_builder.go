@@ -37,8 +37,18 @@ func (b *RunnerPoolStackBuilder) BuildStack(ctx context.Context, l log.Logger, t
 		return nil, err
 	}
 
+	durations, err := unitDurations(terragruntOptions, terragruntOptions.QueueOrderReport, discovered.Paths())
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := queue.NewOrder(terragruntOptions.QueueOrder, durations)
+	if err != nil {
+		return nil, err
+	}
+
 	// build processing queue for discovered configurations
-	q, queueErr := queue.NewQueue(discovered)
+	q, queueErr := queue.NewQueueWithOrder(discovered, order)
 	if queueErr != nil {
 		return nil, queueErr
 	}
@@ -2,14 +2,21 @@ package configstack
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"sync"
+	"time"
 
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -40,14 +47,16 @@ type DependencyOrder int
 // RunningModule represents a module we are trying to "run" (i.e. apply or destroy)
 // as part of the run --all apply or run --all destroy command.
 type RunningModule struct {
-	Err            error
-	Module         *TerraformModule
-	Logger         log.Logger
-	DependencyDone chan *RunningModule
-	Dependencies   map[string]*RunningModule
-	NotifyWhenDone []*RunningModule
-	Status         ModuleStatus
-	FlagExcluded   bool
+	Err                  error
+	Module               *TerraformModule
+	Logger               log.Logger
+	DependencyDone       chan *RunningModule
+	Dependencies         map[string]*RunningModule
+	NotifyWhenDone       []*RunningModule
+	Status               ModuleStatus
+	ConcurrencyGroupWait time.Duration
+	Rank                 int
+	FlagExcluded         bool
 }
 
 // Create a new RunningModule struct for the given module. This will initialize all fields to reasonable defaults,
@@ -66,7 +75,7 @@ func newRunningModule(module *TerraformModule) *RunningModule {
 }
 
 // Run a module once all of its dependencies have finished executing.
-func (module *RunningModule) runModuleWhenReady(ctx context.Context, opts *options.TerragruntOptions, r *report.Report, semaphore chan struct{}) {
+func (module *RunningModule) runModuleWhenReady(ctx context.Context, opts *options.TerragruntOptions, r *report.Report, scheduler *queue.Scheduler) {
 	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "wait_for_module_ready", map[string]any{
 		"path":             module.Module.Path,
 		"terraformCommand": module.Module.TerragruntOptions.TerraformCommand,
@@ -74,10 +83,21 @@ func (module *RunningModule) runModuleWhenReady(ctx context.Context, opts *optio
 		return module.waitForDependencies(opts, r)
 	})
 
-	semaphore <- struct{}{} // Add one to the buffered channel. Will block if parallelism limit is met
-	defer func() {
-		<-semaphore // Remove one from the buffered channel
-	}()
+	ready := err == nil
+
+	if err == nil {
+		// Blocks while the parallelism limit, or the limit of the concurrency group of the module, is met.
+		group, weight := module.Module.Config.Concurrency.GroupName(), module.Module.Config.Concurrency.UnitWeight()
+
+		module.ConcurrencyGroupWait, err = scheduler.Acquire(ctx, group, weight, module.Rank)
+		if err == nil {
+			defer scheduler.Release(group, weight)
+
+			if module.ConcurrencyGroupWait > 0 {
+				module.Logger.Debugf("Module %s waited %s for concurrency group %s", module.Module.Path, module.ConcurrencyGroupWait, group)
+			}
+		}
+	}
 
 	if err == nil {
 		err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "run_module", map[string]any{
@@ -88,6 +108,12 @@ func (module *RunningModule) runModuleWhenReady(ctx context.Context, opts *optio
 		})
 	}
 
+	if err != nil && ready {
+		if timeoutErr := new(QueueTimeoutError); errors.As(context.Cause(ctx), timeoutErr) {
+			err = errors.New(QueueTimeoutError{Timeout: timeoutErr.Timeout, Err: err})
+		}
+	}
+
 	module.moduleFinished(err, r, opts.Experiments.Evaluate(experiment.Report))
 }
 
@@ -159,12 +185,32 @@ func (module *RunningModule) runTerragrunt(ctx context.Context, opts *options.Te
 			return err
 		}
 
+		run.Selection = module.Module.Selection
+		run.Fingerprint = module.Module.Fingerprint
+		run.ConcurrencyGroup = module.Module.Config.Concurrency.GroupName()
+		run.ConcurrencyGroupWait = module.ConcurrencyGroupWait
+
 		if err := r.AddRun(run); err != nil {
 			return err
 		}
 	}
 
-	return opts.RunTerragrunt(ctx, module.Logger, opts, r)
+	if err := opts.RunTerragrunt(ctx, module.Logger, opts, r); err != nil {
+		return err
+	}
+
+	if opts.Experiments.Evaluate(experiment.Report) {
+		fingerprint, err := module.Module.fingerprintInputs(ctx, module.Logger)
+		if err != nil {
+			module.Logger.Warnf("Not adding the inputs of unit %s to its fingerprint, resuming from the report of this run will fail: %v", module.Module.Path, err)
+
+			return nil
+		}
+
+		module.Module.Fingerprint = fingerprint
+	}
+
+	return nil
 }
 
 // Run a module right now by executing the RunTerragrunt command of its TerragruntOptions field.
@@ -214,13 +260,47 @@ func (module *RunningModule) runNow(ctx context.Context, rootOptions *options.Te
 	}
 }
 
+// failureEndOptions returns how the run of a module that finished with the given error is reported. Modules stopped by
+// their `timeout` attribute, or by the `--queue-timeout`, are reported as timed out, and as early exits if they did
+// not start.
+func (module *RunningModule) failureEndOptions(moduleErr error) []report.EndOption {
+	var timeout time.Duration
+
+	if unitErr := new(config.TimeoutError); errors.As(moduleErr, unitErr) {
+		timeout = unitErr.Timeout
+	} else if queueErr := new(QueueTimeoutError); errors.As(moduleErr, queueErr) {
+		timeout = queueErr.Timeout
+	}
+
+	if timeout == 0 {
+		return []report.EndOption{
+			report.WithResult(report.ResultFailed),
+			report.WithReason(report.ReasonRunError),
+			report.WithCauseRunError(moduleErr.Error()),
+			report.WithError(moduleErr),
+		}
+	}
+
+	result := report.ResultFailed
+	if module.Status != Running {
+		result = report.ResultEarlyExit
+	}
+
+	return []report.EndOption{
+		report.WithResult(result),
+		report.WithReason(report.ReasonTimeout),
+		report.WithCauseTimeout(timeout.String()),
+		report.WithError(moduleErr),
+	}
+}
+
 // Record that a module has finished executing and notify all of this module's dependencies
 func (module *RunningModule) moduleFinished(moduleErr error, r *report.Report, reportExperiment bool) {
 	if moduleErr == nil {
 		module.Logger.Debugf("Module %s has finished successfully!", module.Module.Path)
 
 		if reportExperiment {
-			if err := r.EndRun(module.Module.Path); err != nil {
+			if err := r.EndRun(module.Module.Path, report.WithFingerprint(module.Module.Fingerprint)); err != nil {
 				// If the run is not found in the report, it likely means this module was an external dependency
 				// that was excluded from the queue (e.g., with --queue-exclude-external).
 				if !errors.Is(err, report.ErrRunNotFound) {
@@ -236,16 +316,28 @@ func (module *RunningModule) moduleFinished(moduleErr error, r *report.Report, r
 						return
 					}
 
+					run.Fingerprint = module.Module.Fingerprint
+
 					if err := r.AddRun(run); err != nil {
 						module.Logger.Errorf("Error adding run for unit %s: %v", module.Module.Path, err)
 						return
 					}
 
-					if err := r.EndRun(
-						run.Path,
+					endOptions := []report.EndOption{
 						report.WithResult(report.ResultExcluded),
 						report.WithReason(report.ReasonExcludeExternal),
-					); err != nil {
+					}
+
+					// Units that succeeded in the report the run was resumed from are reported as succeeded again, so
+					// the run can be resumed from the new report too.
+					if module.Module.Resumed {
+						endOptions = []report.EndOption{
+							report.WithResult(report.ResultSucceeded),
+							report.WithReason(report.ReasonAlreadySucceeded),
+						}
+					}
+
+					if err := r.EndRun(run.Path, endOptions...); err != nil {
 						module.Logger.Errorf("Error ending run for unit %s: %v", module.Module.Path, err)
 					}
 				}
@@ -255,12 +347,9 @@ func (module *RunningModule) moduleFinished(moduleErr error, r *report.Report, r
 		module.Logger.Errorf("Module %s has finished with an error", module.Module.Path)
 
 		if reportExperiment {
-			if err := r.EndRun(
-				module.Module.Path,
-				report.WithResult(report.ResultFailed),
-				report.WithReason(report.ReasonRunError),
-				report.WithCauseRunError(moduleErr.Error()),
-			); err != nil {
+			endOptions := module.failureEndOptions(moduleErr)
+
+			if err := r.EndRun(module.Module.Path, endOptions...); err != nil {
 				if errors.Is(err, report.ErrRunNotFound) {
 					run, err := report.NewRun(module.Module.Path)
 					if err != nil {
@@ -273,12 +362,7 @@ func (module *RunningModule) moduleFinished(moduleErr error, r *report.Report, r
 						return
 					}
 
-					if err := r.EndRun(
-						run.Path,
-						report.WithResult(report.ResultFailed),
-						report.WithReason(report.ReasonRunError),
-						report.WithCauseRunError(moduleErr.Error()),
-					); err != nil {
+					if err := r.EndRun(run.Path, endOptions...); err != nil {
 						module.Logger.Errorf("Error ending run for unit %s: %v", module.Module.Path, err)
 					}
 				} else {
@@ -451,18 +535,36 @@ func (modules RunningModules) RemoveFlagExcluded(r *report.Report, reportExperim
 // TerragruntOptions object. The modules will be executed in an order determined by their inter-dependencies, using
 // as much concurrency as possible.
 func (modules RunningModules) runModules(ctx context.Context, opts *options.TerragruntOptions, r *report.Report, parallelism int) error {
+	if err := modules.rankModules(opts); err != nil {
+		return err
+	}
+
+	// Bound the whole run by the `--queue-timeout`. Once it expires, the running modules are interrupted, and the
+	// modules that did not start are not run.
+	if opts.QueueTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.QueueTimeout, QueueTimeoutError{Timeout: opts.QueueTimeout})
+		defer cancel()
+	}
+
 	var (
 		waitGroup sync.WaitGroup
-		semaphore = make(chan struct{}, parallelism) // Make a semaphore from a buffered channel
+		scheduler = queue.NewScheduler(parallelism, modules.concurrencyGroupLimits())
 	)
 
-	for _, module := range modules {
+	// Start the modules in order of their rank, so the first ones ready to run are queued in that order too.
+	sorted := slices.SortedFunc(maps.Values(modules), func(a, b *RunningModule) int {
+		return cmp.Compare(a.Rank, b.Rank)
+	})
+
+	for _, module := range sorted {
 		waitGroup.Add(1)
 
 		go func(module *RunningModule) {
 			defer waitGroup.Done()
 
-			module.runModuleWhenReady(ctx, opts, r, semaphore)
+			module.runModuleWhenReady(ctx, opts, r, scheduler)
 		}(module)
 	}
 
@@ -471,6 +573,90 @@ func (modules RunningModules) runModules(ctx context.Context, opts *options.Terr
 	return modules.collectErrors()
 }
 
+// rankModules ranks the modules in the order of the TerragruntOptions QueueOrder attribute, so that the modules
+// ready to run start in that order when the parallelism limit is met.
+func (modules RunningModules) rankModules(opts *options.TerragruntOptions) error {
+	discovered := modules.toDiscoveredConfigs()
+
+	durations, err := unitDurations(opts, opts.QueueOrderReport, discovered.Paths())
+	if err != nil {
+		return err
+	}
+
+	order, err := queue.NewOrder(opts.QueueOrder, durations)
+	if err != nil {
+		return err
+	}
+
+	ranks := order.Ranks(discovered)
+
+	for _, module := range modules {
+		module.Rank = ranks[module.Module.Path]
+	}
+
+	return nil
+}
+
+// toDiscoveredConfigs converts the modules to discovered configurations whose dependencies are the modules each
+// module waits on, which are its dependents when running in reverse order.
+func (modules RunningModules) toDiscoveredConfigs() discovery.DiscoveredConfigs {
+	configs := make(map[string]*discovery.DiscoveredConfig, len(modules))
+	for path := range modules {
+		configs[path] = &discovery.DiscoveredConfig{Path: path, Type: discovery.ConfigTypeUnit}
+	}
+
+	result := make(discovery.DiscoveredConfigs, 0, len(modules))
+
+	for path, module := range modules {
+		for dependencyPath := range module.Dependencies {
+			if dependency, ok := configs[dependencyPath]; ok {
+				configs[path].Dependencies = append(configs[path].Dependencies, dependency)
+			}
+		}
+
+		result = append(result, configs[path])
+	}
+
+	return result
+}
+
+// concurrencyGroupLimits returns the limit of each concurrency group of the given modules. If the modules of a group
+// set different limits, the lowest one applies and a warning names the modules that disagree.
+func (modules RunningModules) concurrencyGroupLimits() map[string]int {
+	var (
+		limits  = make(map[string]int)
+		sources = make(map[string]string)
+	)
+
+	for _, path := range slices.Sorted(maps.Keys(modules)) {
+		module := modules[path]
+
+		group, limit := module.Module.Config.Concurrency.GroupName(), module.Module.Config.Concurrency.GroupLimit()
+		if group == "" || limit == 0 {
+			continue
+		}
+
+		current, ok := limits[group]
+		if !ok {
+			limits[group], sources[group] = limit, module.Module.Path
+
+			continue
+		}
+
+		if limit == current {
+			continue
+		}
+
+		module.Logger.Warnf("Module %s sets limit %d for concurrency group %s, but module %s sets limit %d. The lowest limit %d applies.", module.Module.Path, limit, group, sources[group], current, min(limit, current))
+
+		if limit < current {
+			limits[group], sources[group] = limit, module.Module.Path
+		}
+	}
+
+	return limits
+}
+
 // Collect the errors from the given modules and return a single error object to represent them, or nil if no errors
 // occurred
 func (modules RunningModules) collectErrors() error {
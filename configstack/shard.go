@@ -0,0 +1,152 @@
+package configstack
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// flagUnitsOutsideShard splits the modules that will run into the number of shards in the TerragruntOptions
+// QueueShard attribute, and flags the modules outside the given shard as excluded. The dependencies between the
+// modules of the shard and the modules of other shards are logged, as they are not enforced across shards.
+func (modules TerraformModules) flagUnitsOutsideShard(l log.Logger, opts *options.TerragruntOptions, r *report.Report) (TerraformModules, error) {
+	if opts.QueueShard == "" {
+		return modules, nil
+	}
+
+	shard, err := queue.ParseShard(opts.QueueShard)
+	if err != nil {
+		return nil, err
+	}
+
+	discovered := modules.toDiscoveredConfigs()
+
+	durations, err := unitDurations(opts, opts.QueueShardReport, discovered.Paths())
+	if err != nil {
+		return nil, err
+	}
+
+	shards, err := queue.NewShards(discovered, shard.Count, durations)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dep := range shards.CrossShardDependencies {
+		if dep.DependentShard == shard.Index {
+			l.Warnf("Unit %s depends on unit %s, which runs in shard %d/%d", dep.Dependent.Path, dep.Dependency.Path, dep.DependencyShard, shard.Count)
+		}
+	}
+
+	var unitsInShard int
+
+	for _, module := range modules {
+		if module.FlagExcluded || module.AssumeAlreadyApplied {
+			continue
+		}
+
+		if shards.Shard(module.Path) == shard.Index {
+			unitsInShard++
+
+			continue
+		}
+
+		module.FlagExcluded = true
+
+		if !opts.Experiments.Evaluate(experiment.Report) {
+			continue
+		}
+
+		run, err := report.NewRun(module.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.AddRun(run); err != nil {
+			return nil, err
+		}
+
+		if err := r.EndRun(run.Path, report.WithResult(report.ResultExcluded), report.WithReason(report.ReasonQueueShard)); err != nil {
+			return nil, err
+		}
+	}
+
+	l.Infof("Running shard %s with %d unit(s)", shard, unitsInShard)
+
+	return modules, nil
+}
+
+// toDiscoveredConfigs converts the modules that will run to discovered configurations, with their parsed
+// configurations and their dependencies among them.
+func (modules TerraformModules) toDiscoveredConfigs() discovery.DiscoveredConfigs {
+	byPath := make(map[string]*discovery.DiscoveredConfig, len(modules))
+
+	var configs discovery.DiscoveredConfigs
+
+	for _, module := range modules {
+		if module.FlagExcluded || module.AssumeAlreadyApplied {
+			continue
+		}
+
+		cfg := &discovery.DiscoveredConfig{Path: module.Path, Type: discovery.ConfigTypeUnit, Parsed: &module.Config}
+		byPath[module.Path] = cfg
+		configs = append(configs, cfg)
+	}
+
+	for _, module := range modules {
+		cfg, ok := byPath[module.Path]
+		if !ok {
+			continue
+		}
+
+		for _, dependency := range module.Dependencies {
+			if dep, ok := byPath[dependency.Path]; ok {
+				cfg.Dependencies = append(cfg.Dependencies, dep)
+			}
+		}
+	}
+
+	return configs
+}
+
+// unitDurations returns the durations of the units with the given paths in the given run report of a previous run,
+// keyed by path. Relative report paths are resolved from the working directory.
+func unitDurations(opts *options.TerragruntOptions, reportFile string, paths []string) (map[string]time.Duration, error) {
+	if reportFile == "" {
+		return nil, nil
+	}
+
+	reportPath := reportFile
+	if !filepath.IsAbs(reportPath) {
+		reportPath = filepath.Join(opts.WorkingDir, reportPath)
+	}
+
+	runs, err := report.ReadJSONFile(reportPath)
+	if err != nil {
+		return nil, errors.Errorf("failed to read the durations of the units from %s: %w", reportFile, err)
+	}
+
+	runDurations := make(map[string]time.Duration, len(runs))
+
+	for _, run := range runs {
+		if run.Result != string(report.ResultExcluded) && run.Ended.After(run.Started) {
+			runDurations[run.Name] = run.Ended.Sub(run.Started)
+		}
+	}
+
+	durations := make(map[string]time.Duration, len(paths))
+
+	for _, path := range paths {
+		if duration, ok := runDurations[report.NameOfPath(path, opts.WorkingDir)]; ok {
+			durations[path] = duration
+		}
+	}
+
+	return durations, nil
+}
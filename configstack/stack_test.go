@@ -1,7 +1,9 @@
 package configstack_test
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -11,10 +13,14 @@ import (
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/configstack"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/experiment"
+	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
 	"github.com/gruntwork-io/terragrunt/tf"
 	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	goerrors "github.com/go-errors/errors"
@@ -60,6 +66,379 @@ func TestFindStackInSubfolders(t *testing.T) {
 	}
 }
 
+func TestFindStackInSubfoldersChangedSince(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		includeDependents  bool
+		expectedSelections map[string]*report.Selection
+	}{
+		{
+			name: "changed-units",
+			expectedSelections: map[string]*report.Selection{
+				"cache": {Reason: report.SelectionChangedDir, Cause: "cache/main.tf"},
+				"db":    {Reason: report.SelectionChangedFileRead, Cause: "common.hcl"},
+				"vpc":   {Reason: report.SelectionChangedSource, Cause: "modules/vpc/main.tf"},
+			},
+		},
+		{
+			name:              "changed-units-and-dependents",
+			includeDependents: true,
+			expectedSelections: map[string]*report.Selection{
+				"app":   {Reason: report.SelectionChangedDependency, Cause: "vpc"},
+				"cache": {Reason: report.SelectionChangedDir, Cause: "cache/main.tf"},
+				"db":    {Reason: report.SelectionChangedFileRead, Cause: "common.hcl"},
+				"vpc":   {Reason: report.SelectionChangedSource, Cause: "modules/vpc/main.tf"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Resolve symlinks, such as /tmp on macOS, the same way git does.
+			repoDir, err := filepath.EvalSymlinks(t.TempDir())
+			require.NoError(t, err)
+
+			files := map[string]string{
+				"modules/vpc/main.tf":      "# vpc",
+				"vpc/terragrunt.hcl":       "terraform {\n  source = \"../modules/vpc\"\n}\n",
+				"app/terragrunt.hcl":       "dependencies {\n  paths = [\"../vpc\"]\n}\n",
+				"db/terragrunt.hcl":        "locals {\n  common = read_terragrunt_config(\"../common.hcl\")\n}\n",
+				"common.hcl":               "locals {\n  env = \"dev\"\n}\n",
+				"cache/terragrunt.hcl":     "",
+				"cache/main.tf":            "# cache",
+				"unchanged/terragrunt.hcl": "",
+			}
+
+			// Units without a `terraform.source` need OpenTofu/Terraform files to be part of the stack.
+			for _, unit := range []string{"app", "db", "unchanged"} {
+				files[unit+"/main.tf"] = "# " + unit
+			}
+
+			writeStackFiles(t, repoDir, files)
+
+			git := func(args ...string) {
+				cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+				cmd.Dir = repoDir
+
+				out, err := cmd.CombinedOutput()
+				require.NoError(t, err, string(out))
+			}
+
+			git("init", "-q")
+			git("add", "-A")
+			git("commit", "-q", "-m", "init")
+
+			for _, path := range []string{"modules/vpc/main.tf", "common.hcl", "cache/main.tf"} {
+				require.NoError(t, os.WriteFile(filepath.Join(repoDir, path), []byte("# changed\n"), 0644))
+			}
+
+			opts, err := options.NewTerragruntOptionsForTest(filepath.Join(repoDir, config.DefaultTerragruntConfigPath))
+			require.NoError(t, err)
+
+			opts.WorkingDir = repoDir
+			opts.ChangedSince = "HEAD"
+			opts.IncludeChangedDependents = tc.includeDependents
+			opts.ExcludeByDefault = true
+
+			stack, err := configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts)
+			require.NoError(t, err)
+
+			selections := make(map[string]*report.Selection)
+
+			for _, module := range stack.Modules() {
+				relPath, err := filepath.Rel(repoDir, module.Path)
+				require.NoError(t, err)
+
+				assert.Equal(t, module.Selection == nil, module.FlagExcluded, "unit %s", relPath)
+
+				if module.Selection != nil {
+					cause, err := filepath.Rel(repoDir, module.Selection.Cause)
+					require.NoError(t, err)
+
+					selections[relPath] = &report.Selection{Reason: module.Selection.Reason, Cause: filepath.ToSlash(cause)}
+				}
+			}
+
+			assert.Equal(t, tc.expectedSelections, selections)
+		})
+	}
+}
+
+func TestFindStackInSubfoldersChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	rootDir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	files := map[string]string{
+		"modules/vpc/main.tf":      "# vpc",
+		"vpc/terragrunt.hcl":       "terraform {\n  source = \"../modules/vpc\"\n}\n",
+		"app/terragrunt.hcl":       "dependencies {\n  paths = [\"../vpc\"]\n}\n",
+		"app/main.tf":              "# app",
+		"db/terragrunt.hcl":        "locals {\n  common = read_terragrunt_config(\"../common.hcl\")\n}\n",
+		"db/main.tf":               "# db",
+		"common.hcl":               "locals {\n  env = \"dev\"\n}\n",
+		"unchanged/terragrunt.hcl": "",
+		"unchanged/main.tf":        "# unchanged",
+	}
+
+	writeStackFiles(t, rootDir, files)
+
+	newOpts := func() *options.TerragruntOptions {
+		opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+		require.NoError(t, err)
+
+		opts.WorkingDir = rootDir
+
+		return opts
+	}
+
+	// The paths watched by `--watch` are the ones the changed files are matched against.
+	opts := newOpts()
+
+	stack, err := configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts)
+	require.NoError(t, err)
+
+	var watchedPaths []string
+
+	for _, path := range stack.Modules().WatchedPaths(opts) {
+		relPath, err := filepath.Rel(rootDir, path)
+		require.NoError(t, err)
+
+		watchedPaths = append(watchedPaths, filepath.ToSlash(relPath))
+	}
+
+	assert.Subset(t, watchedPaths, []string{"app", "common.hcl", "db", "modules/vpc", "unchanged", "vpc"})
+
+	opts = newOpts()
+	opts.ChangedFiles = []string{filepath.Join(rootDir, "modules", "vpc", "main.tf"), filepath.Join(rootDir, "common.hcl")}
+	opts.IncludeChangedDependents = true
+	opts.ExcludeByDefault = true
+
+	stack, err = configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts)
+	require.NoError(t, err)
+
+	var selected []string
+
+	for _, module := range stack.Modules() {
+		if !module.FlagExcluded {
+			relPath, err := filepath.Rel(rootDir, module.Path)
+			require.NoError(t, err)
+
+			selected = append(selected, filepath.ToSlash(relPath))
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"app", "db", "vpc"}, selected)
+}
+
+func TestFindStackInSubfoldersResumeFrom(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+
+	files := map[string]string{
+		"modules/vpc/main.tf":  "# vpc",
+		"vpc/terragrunt.hcl":   "terraform {\n  source = \"../modules/vpc\"\n}\n",
+		"app/terragrunt.hcl":   "dependencies {\n  paths = [\"../vpc\"]\n}\n",
+		"app/main.tf":          "# app",
+		"db/terragrunt.hcl":    "locals {\n  common = read_terragrunt_config(\"../common.hcl\")\n}\n\ninputs = {\n  size = get_env(\"DB_SIZE\", \"small\")\n}\n",
+		"db/main.tf":           "# db",
+		"common.hcl":           "locals {\n  env = \"dev\"\n}\n",
+		"cache/terragrunt.hcl": "",
+		"cache/main.tf":        "# cache",
+	}
+
+	writeStackFiles(t, rootDir, files)
+
+	reportFile := filepath.Join(rootDir, "report.json")
+
+	newOptions := func(t *testing.T, env map[string]string) *options.TerragruntOptions {
+		t.Helper()
+
+		opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+		require.NoError(t, err)
+		require.NoError(t, opts.Experiments.EnableExperiment(experiment.Report))
+
+		opts.WorkingDir = rootDir
+		opts.TerraformCommand = tf.CommandNameApply
+		opts.TerraformCliArgs = []string{tf.CommandNameApply}
+		opts.ResumeFrom = "report.json"
+		opts.NonInteractive = true
+		opts.Env = env
+
+		return opts
+	}
+
+	findStack := func(t *testing.T, env map[string]string) (configstack.Stack, error) {
+		t.Helper()
+
+		return configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), newOptions(t, env))
+	}
+
+	// Resuming from a report without runs runs all the units. The app and cache units fail, and the report records the
+	// fingerprints of the others, including their inputs.
+	require.NoError(t, os.WriteFile(reportFile, []byte("[]"), 0644))
+
+	opts := newOptions(t, map[string]string{})
+	opts.RunTerragrunt = func(_ context.Context, _ log.Logger, opts *options.TerragruntOptions, _ *report.Report) error {
+		if name := filepath.Base(opts.WorkingDir); name == "app" || name == "cache" {
+			return errors.New("failed")
+		}
+
+		return nil
+	}
+
+	r := report.NewReport().WithWorkingDir(rootDir).WithFormat(report.FormatJSON)
+
+	stack, err := configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts, configstack.WithReport(r))
+	require.NoError(t, err)
+
+	for _, module := range stack.Modules() {
+		assert.False(t, module.AssumeAlreadyApplied, "unit %s", module.Path)
+		require.NotEmpty(t, module.Fingerprint, "unit %s", module.Path)
+	}
+
+	require.Error(t, stack.Run(t.Context(), logger.CreateLogger(), opts))
+	require.NoError(t, r.WriteToFile(reportFile))
+
+	// Files generated by Terragrunt during the run do not change the fingerprint of the unit.
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "db", "backend.tf"), []byte("# "+codegen.TerragruntGeneratedSignature+"\nterraform {}\n"), 0644))
+
+	// Units that succeeded are skipped, the others are run again.
+	stack, err = findStack(t, map[string]string{})
+	require.NoError(t, err)
+
+	for _, module := range stack.Modules() {
+		name := filepath.Base(module.Path)
+		succeeded := name == "vpc" || name == "db"
+
+		assert.Equal(t, succeeded, module.AssumeAlreadyApplied, "unit %s", module.Path)
+		assert.Equal(t, succeeded, module.Resumed, "unit %s", module.Path)
+	}
+
+	// Resuming is refused once the inputs of a unit that succeeded change, even if none of its files changed.
+	_, err = findStack(t, map[string]string{"DB_SIZE": "large"})
+	require.ErrorContains(t, err, "changed since the report: db")
+
+	// Resuming is refused once a file read by a unit that succeeded changes.
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "common.hcl"), []byte("locals {\n  env = \"prod\"\n}\n"), 0644))
+
+	_, err = findStack(t, map[string]string{})
+	require.ErrorContains(t, err, "changed since the report: db")
+}
+
+func TestFindStackInSubfoldersQueueShard(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"vpc/terragrunt.hcl": "",
+		"vpc/main.tf":        "# vpc",
+		"app/terragrunt.hcl": "dependencies {\n  paths = [\"../vpc\"]\n}\n",
+		"app/main.tf":        "# app",
+		"db/terragrunt.hcl":  "",
+		"db/main.tf":         "# db",
+		"dns/terragrunt.hcl": "",
+		"dns/main.tf":        "# dns",
+		"report.json": `[
+  {"Name": "dns", "Result": "succeeded", "Started": "2025-01-01T00:00:00Z", "Ended": "2025-01-01T00:30:00Z"},
+  {"Name": "vpc", "Result": "succeeded", "Started": "2025-01-01T00:00:00Z", "Ended": "2025-01-01T00:01:00Z"}
+]`,
+	}
+
+	findShards := func(t *testing.T, shardReport string) map[string]string {
+		t.Helper()
+
+		shards := make(map[string]string)
+
+		for _, shard := range []string{"1/2", "2/2"} {
+			// Each shard runs from its own checkout, as the modules of a directory are cached.
+			rootDir := t.TempDir()
+
+			writeStackFiles(t, rootDir, files)
+
+			opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+			require.NoError(t, err)
+
+			opts.WorkingDir = rootDir
+			opts.QueueShard = shard
+			opts.QueueShardReport = shardReport
+
+			stack, err := configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts)
+			require.NoError(t, err)
+
+			for _, module := range stack.Modules() {
+				if module.FlagExcluded {
+					continue
+				}
+
+				name := filepath.Base(module.Path)
+				assert.NotContains(t, shards, name, "unit %s runs in more than one shard", name)
+
+				shards[name] = shard
+			}
+		}
+
+		return shards
+	}
+
+	// Every unit runs in a single shard, with the units it depends on.
+	shards := findShards(t, "")
+	require.Len(t, shards, 4)
+	assert.Equal(t, shards["vpc"], shards["app"])
+	assert.NotEqual(t, shards["vpc"], shards["db"])
+	assert.NotEqual(t, shards["vpc"], shards["dns"])
+
+	// Shards are balanced using the durations of the units in the report.
+	shards = findShards(t, "report.json")
+	require.Len(t, shards, 4)
+	assert.Equal(t, shards["vpc"], shards["app"])
+	assert.Equal(t, shards["vpc"], shards["db"])
+	assert.NotEqual(t, shards["vpc"], shards["dns"])
+}
+
+func TestFindStackInSubfoldersQueueFilters(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+
+	files := map[string]string{
+		"vpc/terragrunt.hcl":  "",
+		"vpc/main.tf":         "# vpc",
+		"app/terragrunt.hcl":  "dependencies {\n  paths = [\"../vpc\"]\n}\n",
+		"app/main.tf":         "# app",
+		"db/terragrunt.hcl":   "terraform {\n  source = \"../modules//rds\"\n}\n",
+		"modules/rds/main.tf": "# rds",
+		"dns/terragrunt.hcl":  "",
+		"dns/main.tf":         "# dns",
+	}
+
+	writeStackFiles(t, rootDir, files)
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+	require.NoError(t, err)
+
+	opts.WorkingDir = rootDir
+	opts.QueueFilters = []string{"app | dependency-of=app", "source=*//rds"}
+
+	stack, err := configstack.FindStackInSubfolders(t.Context(), logger.CreateLogger(), opts)
+	require.NoError(t, err)
+
+	var included []string
+
+	for _, module := range stack.Modules() {
+		if !module.FlagExcluded {
+			included = append(included, filepath.Base(module.Path))
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"app", "db", "vpc"}, included)
+}
+
 func TestGetModuleRunGraphApplyOrder(t *testing.T) {
 	t.Parallel()
 
@@ -176,6 +555,16 @@ func createTempFolder(t *testing.T) string {
 	return filepath.ToSlash(tmpFolder)
 }
 
+// writeStackFiles writes the given contents to the files at the given paths relative to rootDir.
+func writeStackFiles(t *testing.T, rootDir string, files map[string]string) {
+	t.Helper()
+
+	for path, contents := range files {
+		createDirIfNotExist(t, filepath.Dir(filepath.Join(rootDir, path)))
+		require.NoError(t, os.WriteFile(filepath.Join(rootDir, path), []byte(contents), 0644))
+	}
+}
+
 // Create a dummy Terragrunt config file at each of the given paths
 func writeDummyTerragruntConfigs(t *testing.T, tmpFolder string, paths []string) {
 	t.Helper()
@@ -1355,3 +1744,42 @@ func TestCircularDependencies(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, result)
 	}
 }
+
+func TestRunnerPoolStackBuilderQueueOrder(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+
+	files := map[string]string{
+		"alone-a/terragrunt.hcl": "",
+		"alone-b/terragrunt.hcl": "",
+		"chain-a/terragrunt.hcl": "",
+		"chain-b/terragrunt.hcl": "dependencies {\n  paths = [\"../chain-a\"]\n}\n",
+	}
+
+	writeStackFiles(t, rootDir, files)
+
+	buildStack := func(t *testing.T, order string) []string {
+		t.Helper()
+
+		opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, config.DefaultTerragruntConfigPath))
+		require.NoError(t, err)
+
+		opts.WorkingDir = rootDir
+		opts.TerraformCommand = tf.CommandNamePlan
+		opts.QueueOrder = order
+
+		stack, err := configstack.NewRunnerPoolStackBuilder().BuildStack(t.Context(), logger.CreateLogger(), opts)
+		require.NoError(t, err)
+
+		var names []string
+		for _, module := range stack.Modules() {
+			names = append(names, filepath.Base(module.Path))
+		}
+
+		return names
+	}
+
+	assert.Equal(t, []string{"alone-a", "alone-b", "chain-a", "chain-b"}, buildStack(t, ""))
+	assert.Equal(t, []string{"chain-a", "alone-a", "alone-b", "chain-b"}, buildStack(t, "critical-path"))
+}
@@ -0,0 +1,219 @@
+package discovery
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/view/diagnostic"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Cycles returns the strongly connected components of the dependency graph that contain a cycle, that is the groups
+// of configs which all depend on each other, directly or not. The graph includes the configs the DiscoveredConfigs
+// depend on, even if they are not in the DiscoveredConfigs anymore, as after RemoveCycles.
+//
+// Each component is ordered along a closed walk through all its configs, starting with the first config in
+// alphabetical order: each config depends on the next one, and the last one on the first one. The components are
+// sorted by their first config.
+func (c DiscoveredConfigs) Cycles() []DiscoveredConfigs {
+	var (
+		index    = make(map[string]int)
+		lowLink  = make(map[string]int)
+		onStack  = make(map[string]bool)
+		stack    DiscoveredConfigs
+		cycles   []DiscoveredConfigs
+		connect  func(cfg *DiscoveredConfig)
+		selfDeps = func(cfg *DiscoveredConfig) bool {
+			return slices.ContainsFunc(cfg.Dependencies, func(dep *DiscoveredConfig) bool { return dep.Path == cfg.Path })
+		}
+	)
+
+	// Tarjan's strongly connected components algorithm.
+	connect = func(cfg *DiscoveredConfig) {
+		index[cfg.Path] = len(index)
+		lowLink[cfg.Path] = index[cfg.Path]
+		stack = append(stack, cfg)
+		onStack[cfg.Path] = true
+
+		for _, dep := range cfg.Dependencies {
+			if _, ok := index[dep.Path]; !ok {
+				connect(dep)
+				lowLink[cfg.Path] = min(lowLink[cfg.Path], lowLink[dep.Path])
+			} else if onStack[dep.Path] {
+				lowLink[cfg.Path] = min(lowLink[cfg.Path], index[dep.Path])
+			}
+		}
+
+		if lowLink[cfg.Path] != index[cfg.Path] {
+			return
+		}
+
+		var component DiscoveredConfigs
+
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last.Path] = false
+
+			component = append(component, last)
+
+			if last.Path == cfg.Path {
+				break
+			}
+		}
+
+		if len(component) > 1 || selfDeps(cfg) {
+			cycles = append(cycles, component.closedWalk())
+		}
+	}
+
+	for _, cfg := range c {
+		if _, ok := index[cfg.Path]; !ok {
+			connect(cfg)
+		}
+	}
+
+	slices.SortFunc(cycles, func(a, b DiscoveredConfigs) int {
+		return strings.Compare(a[0].Path, b[0].Path)
+	})
+
+	return cycles
+}
+
+// closedWalk orders the configs of a strongly connected component along a closed walk through all of them, starting
+// with the first config in alphabetical order, and going each time to the nearest config not visited yet. A config is
+// repeated in the walk if it is the only way between two others.
+func (c DiscoveredConfigs) closedWalk() DiscoveredConfigs {
+	inComponent := make(map[string]bool, len(c))
+	for _, cfg := range c {
+		inComponent[cfg.Path] = true
+	}
+
+	start := slices.MinFunc(c, func(a, b *DiscoveredConfig) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	visited := map[string]bool{start.Path: true}
+	walk := DiscoveredConfigs{start}
+	current := start
+
+	for len(visited) < len(c) {
+		path := shortestPath(current, inComponent, func(cfg *DiscoveredConfig) bool { return !visited[cfg.Path] })
+		for _, cfg := range path {
+			visited[cfg.Path] = true
+		}
+
+		walk = append(walk, path...)
+		current = walk[len(walk)-1]
+	}
+
+	// Go back to the start, which is left out as the walk is closed.
+	back := shortestPath(current, inComponent, func(cfg *DiscoveredConfig) bool { return cfg.Path == start.Path })
+	if len(back) > 1 {
+		walk = append(walk, back[:len(back)-1]...)
+	}
+
+	return walk
+}
+
+// shortestPath returns the shortest path of dependencies from the config, excluded, to the nearest config matching
+// `isTarget`, included, going only through the configs of the component.
+func shortestPath(from *DiscoveredConfig, inComponent map[string]bool, isTarget func(*DiscoveredConfig) bool) DiscoveredConfigs {
+	previous := map[string]*DiscoveredConfig{from.Path: nil}
+	queue := DiscoveredConfigs{from}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		// The dependencies are sorted so that the walk does not depend on the order they were discovered in.
+		deps := slices.SortedFunc(slices.Values(current.Dependencies), func(a, b *DiscoveredConfig) int {
+			return strings.Compare(a.Path, b.Path)
+		})
+
+		for _, dep := range deps {
+			if !inComponent[dep.Path] {
+				continue
+			}
+
+			if _, ok := previous[dep.Path]; ok && !isTarget(dep) {
+				continue
+			}
+
+			previous[dep.Path] = current
+
+			if !isTarget(dep) {
+				queue = append(queue, dep)
+
+				continue
+			}
+
+			path := DiscoveredConfigs{dep}
+			for cfg := current; cfg != nil && cfg.Path != from.Path; cfg = previous[cfg.Path] {
+				path = append(DiscoveredConfigs{cfg}, path...)
+			}
+
+			return path
+		}
+	}
+
+	return nil
+}
+
+// CycleDiagnostics returns the diagnostics explaining the dependency cycle, ordered as returned by Cycles: one for each
+// dependency between two consecutive configs, located at the `dependency` or `dependencies` block creating it.
+func (c DiscoveredConfigs) CycleDiagnostics() diagnostic.Diagnostics {
+	edges := make([]config.DependencyCycleEdge, 0, len(c))
+
+	for i, cfg := range c {
+		dep := c[(i+1)%len(c)]
+
+		edge := config.DependencyCycleEdge{
+			ConfigPath:     cfg.configFilePath(),
+			DependencyPath: dep.configFilePath(),
+		}
+
+		if cfg.Parsed != nil {
+			for _, dependency := range cfg.Parsed.TerragruntDependencies {
+				if dependency.ConfigPath.Type() != cty.String {
+					continue
+				}
+
+				depPath := dependency.ConfigPath.AsString()
+				if !filepath.IsAbs(depPath) {
+					depPath = filepath.Join(cfg.Path, depPath)
+				}
+
+				if filepath.Clean(depPath) == dep.Path {
+					edge.DependencyName = dependency.Name
+
+					break
+				}
+			}
+
+			for _, include := range cfg.Parsed.ProcessedIncludes {
+				includePath := include.Path
+				if !filepath.IsAbs(includePath) {
+					includePath = filepath.Join(cfg.Path, includePath)
+				}
+
+				edge.IncludePaths = append(edge.IncludePaths, includePath)
+			}
+		}
+
+		edges = append(edges, edge)
+	}
+
+	return config.DependencyCycleDiagnostics(edges)
+}
+
+// configFilePath returns the path of the configuration file of the config.
+func (c *DiscoveredConfig) configFilePath() string {
+	if c.Type == ConfigTypeStack {
+		return filepath.Join(c.Path, config.DefaultStackFile)
+	}
+
+	return filepath.Join(c.Path, config.DefaultTerragruntConfigPath)
+}
@@ -0,0 +1,138 @@
+package discovery_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoveredConfigsCycles(t *testing.T) {
+	t.Parallel()
+
+	a := &discovery.DiscoveredConfig{Path: "a"}
+	b := &discovery.DiscoveredConfig{Path: "b"}
+	c := &discovery.DiscoveredConfig{Path: "c"}
+	d := &discovery.DiscoveredConfig{Path: "d"}
+	e := &discovery.DiscoveredConfig{Path: "e"}
+	f := &discovery.DiscoveredConfig{Path: "f"}
+	g := &discovery.DiscoveredConfig{Path: "g"}
+
+	// a -> b -> c -> a and b -> a form one component, only reachable from d.
+	a.Dependencies = discovery.DiscoveredConfigs{b}
+	b.Dependencies = discovery.DiscoveredConfigs{c, a}
+	c.Dependencies = discovery.DiscoveredConfigs{a}
+	d.Dependencies = discovery.DiscoveredConfigs{a, e}
+	// e depends on itself.
+	e.Dependencies = discovery.DiscoveredConfigs{e}
+	// f -> g is not a cycle.
+	f.Dependencies = discovery.DiscoveredConfigs{g}
+
+	cycles := discovery.DiscoveredConfigs{d, f, g}.Cycles()
+	require.Len(t, cycles, 2)
+	assert.Equal(t, []string{"a", "b", "c"}, cycles[0].Paths())
+	assert.Equal(t, []string{"e"}, cycles[1].Paths())
+
+	assert.Empty(t, discovery.DiscoveredConfigs{f, g}.Cycles())
+
+	_, err := discovery.DiscoveredConfigs{d}.CycleCheck()
+	require.EqualError(t, err, "cycle detected in dependency graph: a -> b -> c -> a")
+}
+
+func TestDiscoveredConfigsCyclesClosedWalk(t *testing.T) {
+	t.Parallel()
+
+	hub := &discovery.DiscoveredConfig{Path: "hub"}
+	left := &discovery.DiscoveredConfig{Path: "left"}
+	right := &discovery.DiscoveredConfig{Path: "right"}
+
+	// Both spokes only go through the hub, which is repeated in the walk.
+	hub.Dependencies = discovery.DiscoveredConfigs{left, right}
+	left.Dependencies = discovery.DiscoveredConfigs{hub}
+	right.Dependencies = discovery.DiscoveredConfigs{hub}
+
+	cycles := discovery.DiscoveredConfigs{right, left, hub}.Cycles()
+	require.Len(t, cycles, 1)
+	assert.Equal(t, []string{"hub", "left", "hub", "right"}, cycles[0].Paths())
+}
+
+func TestDiscoveredConfigsCycleDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+
+	testFiles := map[string]string{
+		filepath.Join(tmpDir, "app", "terragrunt.hcl"): `
+include "root" {
+  path = find_in_parent_folders("root.hcl")
+}
+`,
+		filepath.Join(tmpDir, "db", "terragrunt.hcl"): `
+dependencies {
+  paths = ["../vpc"]
+}
+`,
+		filepath.Join(tmpDir, "vpc", "terragrunt.hcl"): `
+dependency "app" {
+  config_path = "../app"
+}
+`,
+		filepath.Join(tmpDir, "root.hcl"): `
+dependency "db" {
+  config_path = "${get_terragrunt_dir()}/../db"
+}
+`,
+	}
+
+	for path, content := range testFiles {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	opts := options.NewTerragruntOptions()
+	opts.WorkingDir = tmpDir
+	opts.RootWorkingDir = tmpDir
+
+	cfgs, err := discovery.NewDiscovery(tmpDir).WithDiscoverDependencies().WithKeepCycles().Discover(t.Context(), logger.CreateLogger(), opts)
+	require.NoError(t, err)
+
+	_, err = cfgs.CycleCheck()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cycle detected in dependency graph: ")
+	assert.Len(t, cfgs, 3)
+
+	cycles := cfgs.Cycles()
+	require.Len(t, cycles, 1)
+
+	appDir := filepath.Join(tmpDir, "app")
+	dbDir := filepath.Join(tmpDir, "db")
+	vpcDir := filepath.Join(tmpDir, "vpc")
+	assert.Equal(t, []string{appDir, dbDir, vpcDir}, cycles[0].Paths())
+
+	diags := cycles[0].CycleDiagnostics()
+	require.Len(t, diags, 3)
+
+	expected := []struct {
+		file   string
+		detail string
+		line   int
+	}{
+		{file: filepath.Join(tmpDir, "root.hcl"), line: 2, detail: `through the dependency "db" block`},
+		{file: filepath.Join(dbDir, "terragrunt.hcl"), line: 3, detail: "through the dependencies block"},
+		{file: filepath.Join(vpcDir, "terragrunt.hcl"), line: 2, detail: `through the dependency "app" block`},
+	}
+
+	for i, want := range expected {
+		require.NotNil(t, diags[i].Range, "diagnostic %d", i)
+		assert.Equal(t, want.file, diags[i].Range.Filename)
+		assert.Equal(t, want.line, diags[i].Range.Start.Line)
+		assert.Equal(t, "Dependency cycle", diags[i].Summary)
+		assert.Contains(t, diags[i].Detail, want.detail)
+		assert.Contains(t, diags[i].Detail, "in the dependency cycle "+filepath.Join(appDir, "terragrunt.hcl")+" -> ")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/config"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/parsecache"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/zclconf/go-cty/cty"
@@ -93,11 +95,17 @@ type Discovery struct {
 	// parseInclude determines whether to parse include configurations.
 	parseInclude bool
 
+	// parseTerraformSource determines whether to parse the source of the terraform block.
+	parseTerraformSource bool
+
 	// discoverExternalDependencies determines whether to discover external dependencies.
 	discoverExternalDependencies bool
 
 	// suppressParseErrors determines whether to suppress errors when parsing Terragrunt configurations.
 	suppressParseErrors bool
+
+	// keepCycles determines whether to keep the configurations in dependency cycles instead of removing them.
+	keepCycles bool
 }
 
 // DiscoveryOption is a function that modifies a Discovery.
@@ -131,6 +139,13 @@ func (d *Discovery) WithSort(sort Sort) *Discovery {
 	return d
 }
 
+// WithKeepCycles sets the KeepCycles flag to true, so that the configurations in dependency cycles are returned.
+func (d *Discovery) WithKeepCycles() *Discovery {
+	d.keepCycles = true
+
+	return d
+}
+
 // WithDiscoverDependencies sets the DiscoverDependencies flag to true.
 func (d *Discovery) WithDiscoverDependencies() *Discovery {
 	d.discoverDependencies = true
@@ -162,6 +177,15 @@ func (d *Discovery) WithParseInclude() *Discovery {
 	return d
 }
 
+// WithParseTerraformSource sets the ParseTerraformSource flag to true.
+func (d *Discovery) WithParseTerraformSource() *Discovery {
+	d.parseTerraformSource = true
+
+	d.requiresParse = true
+
+	return d
+}
+
 // WithMaxDependencyDepth sets the MaxDependencyDepth flag to the given depth.
 func (d *Discovery) WithMaxDependencyDepth(depth int) *Discovery {
 	d.maxDependencyDepth = depth
@@ -213,6 +237,12 @@ func (c *DiscoveredConfig) ContainsDependencyInAncestry(path string) bool {
 
 // Parse parses the discovered configurations.
 func (c *DiscoveredConfig) Parse(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, suppressParseErrors bool) error {
+	return c.parse(ctx, l, opts, suppressParseErrors, false)
+}
+
+// parse parses the discovered configuration, including the source of the terraform block if parseTerraformSource is
+// true.
+func (c *DiscoveredConfig) parse(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, suppressParseErrors, parseTerraformSource bool) error {
 	parseOpts := opts.Clone()
 	parseOpts.WorkingDir = c.Path
 
@@ -229,15 +259,23 @@ func (c *DiscoveredConfig) Parse(ctx context.Context, l log.Logger, opts *option
 
 	parseOpts.TerragruntConfigPath = filepath.Join(parseOpts.WorkingDir, filename)
 
-	parsingCtx := config.NewParsingContext(ctx, l, parseOpts).WithDecodeList(
+	decodeList := []config.PartialDecodeSectionType{
 		config.DependenciesBlock,
 		config.DependencyBlock,
 		config.FeatureFlagsBlock,
 		config.ExcludeBlock,
-	)
+	}
+
+	if parseTerraformSource {
+		decodeList = append(decodeList, config.TerraformSource)
+	}
+
+	parsingCtx := config.NewParsingContext(ctx, l, parseOpts).WithDecodeList(decodeList...)
 
 	//nolint: contextcheck
-	cfg, err := config.ParseConfigFile(parsingCtx, l, parseOpts.TerragruntConfigPath, nil)
+	cfg, err := parsecache.FromOptions(l, opts).Parse(parsingCtx, l, parseOpts.TerragruntConfigPath, nil, func(ctx *config.ParsingContext) (*config.TerragruntConfig, error) {
+		return config.ParseConfigFile(ctx, l, parseOpts.TerragruntConfigPath, nil)
+	})
 	if err != nil {
 		if !suppressParseErrors || cfg == nil {
 			l.Debugf("Unrecoverable parse error for %s: %s", parseOpts.TerragruntConfigPath, err)
@@ -338,7 +376,7 @@ func (d *Discovery) Discover(ctx context.Context, l log.Logger, opts *options.Te
 	// e.g. dependencies, exclude, etc.
 	if d.requiresParse {
 		for _, cfg := range cfgs {
-			err := cfg.Parse(ctx, l, opts, d.suppressParseErrors)
+			err := cfg.parse(ctx, l, opts, d.suppressParseErrors, d.parseTerraformSource)
 			if err != nil {
 				errs = append(errs, errors.New(err))
 			}
@@ -381,12 +419,16 @@ func (d *Discovery) Discover(ctx context.Context, l log.Logger, opts *options.Te
 			return cfgs, errors.New(err)
 		}
 
+		if d.keepCycles {
+			return cfgs, errors.Join(errs...)
+		}
+
 		err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "discovery_cycle_check", map[string]any{
 			"working_dir":  d.workingDir,
 			"config_count": len(cfgs),
 		}, func(ctx context.Context) error {
 			if _, err := cfgs.CycleCheck(); err != nil {
-				l.Warnf("Cycle detected in dependency graph, attempting removal of cycles.")
+				l.Warnf("Cycle detected in dependency graph, attempting removal of cycles. Run `terragrunt dag cycles` to explain all the cycles.")
 
 				l.Debugf("Cycle: %w", err)
 
@@ -508,7 +550,7 @@ func (d *DependencyDiscovery) DiscoverDependencies(ctx context.Context, l log.Lo
 			depPath = filepath.Join(dCfg.Path, depPath)
 		}
 
-		depPaths = append(depPaths, depPath)
+		depPaths = append(depPaths, filepath.Clean(depPath))
 	}
 
 	if dCfg.Parsed.Dependencies != nil {
@@ -517,7 +559,7 @@ func (d *DependencyDiscovery) DiscoverDependencies(ctx context.Context, l log.Lo
 				dependency = filepath.Join(dCfg.Path, dependency)
 			}
 
-			depPaths = append(depPaths, dependency)
+			depPaths = append(depPaths, filepath.Clean(dependency))
 		}
 	}
 
@@ -638,17 +680,24 @@ func (c DiscoveredConfigs) Paths() []string {
 }
 
 // CycleCheck checks for cycles in the dependency graph.
-// If a cycle is detected, it returns the first DiscoveredConfig that is part of the cycle, and an error.
+// If a cycle is detected, it returns the first DiscoveredConfig that is part of the cycle, and an error listing every
+// config of the cycle in order.
 // If no cycle is detected, it returns nil and nil.
 func (c DiscoveredConfigs) CycleCheck() (*DiscoveredConfig, error) {
 	visited := make(map[string]bool)
 	inPath := make(map[string]bool)
 
-	var checkCycle func(cfg *DiscoveredConfig) error
+	var (
+		path       []string
+		checkCycle func(cfg *DiscoveredConfig) error
+	)
 
 	checkCycle = func(cfg *DiscoveredConfig) error {
 		if inPath[cfg.Path] {
-			return errors.New("cycle detected in dependency graph at path: " + cfg.Path)
+			// Only the end of the path, from the config, is part of the cycle.
+			cycle := append(path[slices.Index(path, cfg.Path):], cfg.Path)
+
+			return errors.New("cycle detected in dependency graph: " + strings.Join(cycle, " -> "))
 		}
 
 		if visited[cfg.Path] {
@@ -657,6 +706,7 @@ func (c DiscoveredConfigs) CycleCheck() (*DiscoveredConfig, error) {
 
 		visited[cfg.Path] = true
 		inPath[cfg.Path] = true
+		path = append(path, cfg.Path)
 
 		for _, dep := range cfg.Dependencies {
 			if err := checkCycle(dep); err != nil {
@@ -665,6 +715,7 @@ func (c DiscoveredConfigs) CycleCheck() (*DiscoveredConfig, error) {
 		}
 
 		inPath[cfg.Path] = false
+		path = path[:len(path)-1]
 
 		return nil
 	}
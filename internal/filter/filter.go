@@ -0,0 +1,383 @@
+// Package filter provides the filter expressions selecting Terragrunt configurations in `find`, `list` and
+// `run --all`.
+//
+// A filter expression is made of predicates, like `type=unit` or `reads=common.hcl`, combined with `&` (and),
+// `|` (or), `!` (not) and parentheses. A predicate without an attribute, like `live/prod/**`, matches the path.
+package filter
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/mattn/go-zglob"
+)
+
+const (
+	// AttributePath matches the path of the configuration with a glob, relative to the working directory.
+	AttributePath Attribute = "path"
+	// AttributeType matches the type of the configuration: unit or stack.
+	AttributeType Attribute = "type"
+	// AttributeReads matches the configurations including, or reading with an HCL function, a file matching a glob
+	// relative to the working directory.
+	AttributeReads Attribute = "reads"
+	// AttributeSource matches the `terraform.source` of the configuration with a pattern, in which `*` matches any
+	// characters.
+	AttributeSource Attribute = "source"
+	// AttributeExclude matches the configurations whose exclude block lists the action.
+	AttributeExclude Attribute = "exclude"
+	// AttributeFeature matches the configurations defining a feature flag whose name matches a pattern, in which `*`
+	// matches any characters.
+	AttributeFeature Attribute = "feature"
+	// AttributeDependsOn matches the configurations depending, directly or not, on a configuration whose path
+	// matches a glob.
+	AttributeDependsOn Attribute = "depends-on"
+	// AttributeDependencyOf matches the configurations that a configuration whose path matches a glob depends on,
+	// directly or not.
+	AttributeDependencyOf Attribute = "dependency-of"
+)
+
+// Attributes are the attributes of the configurations that filters can match.
+var Attributes = []Attribute{
+	AttributePath,
+	AttributeType,
+	AttributeReads,
+	AttributeSource,
+	AttributeExclude,
+	AttributeFeature,
+	AttributeDependsOn,
+	AttributeDependencyOf,
+}
+
+// Attribute is an attribute of the configurations that filters can match.
+type Attribute string
+
+// Filter is a parsed filter expression.
+type Filter struct {
+	expr   expression
+	source string
+}
+
+// Filters are filter expressions, selecting the configurations that match at least one of them.
+type Filters []*Filter
+
+// Parse parses the filter expression.
+func Parse(source string) (*Filter, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", source, err)
+	}
+
+	if len(tokens) == 1 {
+		return nil, fmt.Errorf("invalid filter %q: the expression is empty", source)
+	}
+
+	p := &parser{tokens: tokens}
+
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", source, err)
+	}
+
+	if tok := p.next(); tok.kind != tokenEnd {
+		return nil, fmt.Errorf("invalid filter %q: %w", source, unexpected(tok, "'&', '|' or the end of the expression"))
+	}
+
+	return &Filter{expr: expr, source: source}, nil
+}
+
+// ParseAll parses the filter expressions.
+func ParseAll(sources []string) (Filters, error) {
+	filters := make(Filters, 0, len(sources))
+
+	for _, source := range sources {
+		filter, err := Parse(source)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (filter *Filter) String() string {
+	return filter.source
+}
+
+// Uses returns true if the filter matches any of the given attributes.
+func (filter *Filter) Uses(attributes ...Attribute) bool {
+	return slices.ContainsFunc(attributes, filter.expr.uses)
+}
+
+// Uses returns true if any of the filters matches any of the given attributes.
+func (filters Filters) Uses(attributes ...Attribute) bool {
+	for _, filter := range filters {
+		if filter.Uses(attributes...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequiresParse returns true if the filters match attributes only known once the configurations are parsed.
+func (filters Filters) RequiresParse() bool {
+	return filters.Uses(AttributeReads, AttributeSource, AttributeExclude, AttributeFeature)
+}
+
+// RequiresDependencies returns true if the filters match the dependencies of the configurations.
+func (filters Filters) RequiresDependencies() bool {
+	return filters.Uses(AttributeDependsOn, AttributeDependencyOf)
+}
+
+// Select returns the configurations matching at least one of the filters, in their original order. All the
+// configurations are returned if there are no filters. Relative globs are matched from the working directory of the
+// options, and the files read by the configurations are the ones recorded in the options while parsing them.
+func (filters Filters) Select(opts *options.TerragruntOptions, cfgs discovery.DiscoveredConfigs) discovery.DiscoveredConfigs {
+	if len(filters) == 0 {
+		return cfgs
+	}
+
+	ev := newEvaluator(opts, cfgs)
+
+	selected := make(discovery.DiscoveredConfigs, 0, len(cfgs))
+
+	for _, cfg := range cfgs {
+		for _, filter := range filters {
+			if filter.expr.matches(ev, cfg) {
+				selected = append(selected, cfg)
+
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// evaluator holds what the filters need to know about the configurations beyond each configuration itself.
+type evaluator struct {
+	workingDir string
+	readFiles  map[string][]string
+	dependents map[string]discovery.DiscoveredConfigs
+}
+
+func newEvaluator(opts *options.TerragruntOptions, cfgs discovery.DiscoveredConfigs) *evaluator {
+	ev := &evaluator{
+		workingDir: opts.WorkingDir,
+		readFiles:  make(map[string][]string),
+		dependents: make(map[string]discovery.DiscoveredConfigs),
+	}
+
+	if opts.ReadFiles != nil {
+		opts.ReadFiles.Range(func(file string, units []string) bool {
+			for _, unit := range units {
+				ev.readFiles[unit] = append(ev.readFiles[unit], file)
+			}
+
+			return true
+		})
+	}
+
+	for _, cfg := range cfgs {
+		for _, dep := range cfg.Dependencies {
+			ev.dependents[dep.Path] = append(ev.dependents[dep.Path], cfg)
+		}
+	}
+
+	return ev
+}
+
+// matchPath returns true if the path matches the glob. Relative globs are matched from the working directory.
+func (ev *evaluator) matchPath(glob, path string) bool {
+	// A lone `**` does not match nested paths with zglob.
+	if glob == "**" {
+		return true
+	}
+
+	if !filepath.IsAbs(glob) {
+		if rel, err := filepath.Rel(ev.workingDir, path); err == nil {
+			path = rel
+		}
+	}
+
+	ok, err := zglob.Match(filepath.ToSlash(glob), filepath.ToSlash(path))
+
+	return err == nil && ok
+}
+
+// filesRead returns the files the configuration includes or reads with an HCL function.
+func (ev *evaluator) filesRead(cfg *discovery.DiscoveredConfig) []string {
+	files := slices.Clone(ev.readFiles[cfg.Path])
+
+	if cfg.Parsed != nil {
+		for _, include := range cfg.Parsed.ProcessedIncludes {
+			if path, err := util.CanonicalPath(include.Path, cfg.Path); err == nil {
+				files = append(files, path)
+			}
+		}
+	}
+
+	return files
+}
+
+// related returns true if any configuration reachable from the configuration through the edges returned by `next`
+// has a path matching the glob.
+func (ev *evaluator) related(cfg *discovery.DiscoveredConfig, glob string, next func(*discovery.DiscoveredConfig) discovery.DiscoveredConfigs) bool {
+	visited := map[string]bool{cfg.Path: true}
+	pending := slices.Clone(next(cfg))
+
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		if visited[current.Path] {
+			continue
+		}
+
+		visited[current.Path] = true
+
+		if ev.matchPath(glob, current.Path) {
+			return true
+		}
+
+		pending = append(pending, next(current)...)
+	}
+
+	return false
+}
+
+// expression is a node of a parsed filter expression.
+type expression interface {
+	matches(ev *evaluator, cfg *discovery.DiscoveredConfig) bool
+	uses(attribute Attribute) bool
+}
+
+type andExpression struct {
+	left, right expression
+}
+
+func (expr *andExpression) matches(ev *evaluator, cfg *discovery.DiscoveredConfig) bool {
+	return expr.left.matches(ev, cfg) && expr.right.matches(ev, cfg)
+}
+
+func (expr *andExpression) uses(attribute Attribute) bool {
+	return expr.left.uses(attribute) || expr.right.uses(attribute)
+}
+
+type orExpression struct {
+	left, right expression
+}
+
+func (expr *orExpression) matches(ev *evaluator, cfg *discovery.DiscoveredConfig) bool {
+	return expr.left.matches(ev, cfg) || expr.right.matches(ev, cfg)
+}
+
+func (expr *orExpression) uses(attribute Attribute) bool {
+	return expr.left.uses(attribute) || expr.right.uses(attribute)
+}
+
+type notExpression struct {
+	operand expression
+}
+
+func (expr *notExpression) matches(ev *evaluator, cfg *discovery.DiscoveredConfig) bool {
+	return !expr.operand.matches(ev, cfg)
+}
+
+func (expr *notExpression) uses(attribute Attribute) bool {
+	return expr.operand.uses(attribute)
+}
+
+// predicate matches an attribute of the configurations against a value.
+type predicate struct {
+	pattern   *regexp.Regexp
+	attribute Attribute
+	value     string
+}
+
+func newPredicate(attribute Attribute, value string) (*predicate, error) {
+	pred := &predicate{attribute: attribute, value: value}
+
+	switch attribute {
+	case AttributePath, AttributeReads, AttributeDependsOn, AttributeDependencyOf:
+		pred.value = filepath.Clean(value)
+	case AttributeType:
+		if value != string(discovery.ConfigTypeUnit) && value != string(discovery.ConfigTypeStack) {
+			return nil, fmt.Errorf("invalid type %q, expected %s or %s", value, discovery.ConfigTypeUnit, discovery.ConfigTypeStack)
+		}
+	case AttributeSource, AttributeFeature:
+		pred.pattern = wildcardPattern(value)
+	case AttributeExclude:
+	default:
+		names := make([]string, len(Attributes))
+		for i, name := range Attributes {
+			names[i] = string(name)
+		}
+
+		return nil, fmt.Errorf("unknown attribute %q, expected one of %s", attribute, strings.Join(names, ", "))
+	}
+
+	return pred, nil
+}
+
+func (pred *predicate) uses(attribute Attribute) bool {
+	return pred.attribute == attribute
+}
+
+func (pred *predicate) matches(ev *evaluator, cfg *discovery.DiscoveredConfig) bool {
+	switch pred.attribute {
+	case AttributePath:
+		return ev.matchPath(pred.value, cfg.Path)
+	case AttributeType:
+		return string(cfg.Type) == pred.value
+	case AttributeReads:
+		return slices.ContainsFunc(ev.filesRead(cfg), func(file string) bool {
+			return ev.matchPath(pred.value, file)
+		})
+	case AttributeSource:
+		if cfg.Parsed == nil || cfg.Parsed.Terraform == nil || cfg.Parsed.Terraform.Source == nil {
+			return false
+		}
+
+		return pred.pattern.MatchString(*cfg.Parsed.Terraform.Source)
+	case AttributeExclude:
+		return cfg.Parsed != nil && cfg.Parsed.Exclude != nil && cfg.Parsed.Exclude.IsActionListed(pred.value)
+	case AttributeFeature:
+		if cfg.Parsed == nil {
+			return false
+		}
+
+		return slices.ContainsFunc(cfg.Parsed.FeatureFlags, func(flag *config.FeatureFlag) bool {
+			return pred.pattern.MatchString(flag.Name)
+		})
+	case AttributeDependsOn:
+		return ev.related(cfg, pred.value, func(cfg *discovery.DiscoveredConfig) discovery.DiscoveredConfigs {
+			return cfg.Dependencies
+		})
+	case AttributeDependencyOf:
+		return ev.related(cfg, pred.value, func(cfg *discovery.DiscoveredConfig) discovery.DiscoveredConfigs {
+			return ev.dependents[cfg.Path]
+		})
+	}
+
+	return false
+}
+
+// wildcardPattern returns the regular expression matching the whole value, in which `*` matches any characters and
+// `?` matches a single character.
+func wildcardPattern(value string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(value)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+
+	return regexp.MustCompile("^" + quoted + "$")
+}
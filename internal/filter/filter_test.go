@@ -0,0 +1,154 @@
+package filter_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		source      string
+		expectedErr string
+	}{
+		{name: "path", source: "live/prod/**"},
+		{name: "attribute", source: "type=unit"},
+		{name: "operators", source: "!(type=stack | path=live/*) & reads=common.hcl"},
+		{name: "quoted", source: `source="git::https://example.com/modules.git//vpc?ref=v1.0.0"`},
+		{name: "spaces", source: "  depends-on = live/vpc  "},
+		{name: "empty", source: "  ", expectedErr: "the expression is empty"},
+		{name: "unknown attribute", source: "color=blue", expectedErr: `unknown attribute "color"`},
+		{name: "invalid type", source: "type=module", expectedErr: `invalid type "module"`},
+		{name: "missing value", source: "type=", expectedErr: "expected a value at the end of the expression"},
+		{name: "missing operand", source: "type=unit &", expectedErr: "expected a predicate at the end of the expression"},
+		{name: "unclosed parenthesis", source: "(type=unit", expectedErr: "expected ')' at the end of the expression"},
+		{name: "missing operator", source: "type=unit live", expectedErr: `expected '&', '|' or the end of the expression at position 11, found "live"`},
+		{name: "unterminated quote", source: `source="git::`, expectedErr: "unterminated quoted string at position 8"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := filter.Parse(tc.source)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.source, f.String())
+		})
+	}
+}
+
+func TestFiltersRequirements(t *testing.T) {
+	t.Parallel()
+
+	filters, err := filter.ParseAll([]string{"live/**", "type=unit"})
+	require.NoError(t, err)
+	assert.False(t, filters.RequiresParse())
+	assert.False(t, filters.RequiresDependencies())
+
+	filters, err = filter.ParseAll([]string{"live/**", "!feature=beta"})
+	require.NoError(t, err)
+	assert.True(t, filters.RequiresParse())
+	assert.False(t, filters.RequiresDependencies())
+
+	filters, err = filter.ParseAll([]string{"type=unit & dependency-of=live/app"})
+	require.NoError(t, err)
+	assert.False(t, filters.RequiresParse())
+	assert.True(t, filters.RequiresDependencies())
+}
+
+func TestFiltersSelect(t *testing.T) {
+	t.Parallel()
+
+	workingDir := filepath.Join(t.TempDir(), "repo")
+
+	source := "git::https://github.com/acme/modules.git//vpc?ref=v1.2.0"
+
+	vpc := &discovery.DiscoveredConfig{
+		Type: discovery.ConfigTypeUnit,
+		Path: filepath.Join(workingDir, "live", "prod", "vpc"),
+		Parsed: &config.TerragruntConfig{
+			Terraform: &config.TerraformConfig{Source: &source},
+			ProcessedIncludes: config.IncludeConfigsMap{
+				"root": {Name: "root", Path: filepath.Join(workingDir, "root.hcl")},
+			},
+		},
+	}
+	db := &discovery.DiscoveredConfig{
+		Type:         discovery.ConfigTypeUnit,
+		Path:         filepath.Join(workingDir, "live", "prod", "db"),
+		Dependencies: discovery.DiscoveredConfigs{vpc},
+		Parsed: &config.TerragruntConfig{
+			Exclude:      &config.ExcludeConfig{Actions: []string{"destroy"}},
+			FeatureFlags: config.FeatureFlags{{Name: "enable_replica"}},
+		},
+	}
+	app := &discovery.DiscoveredConfig{
+		Type:         discovery.ConfigTypeUnit,
+		Path:         filepath.Join(workingDir, "live", "prod", "app"),
+		Dependencies: discovery.DiscoveredConfigs{db},
+		Parsed:       &config.TerragruntConfig{},
+	}
+	stack := &discovery.DiscoveredConfig{
+		Type: discovery.ConfigTypeStack,
+		Path: filepath.Join(workingDir, "stacks", "prod"),
+	}
+
+	cfgs := discovery.DiscoveredConfigs{vpc, db, app, stack}
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(workingDir, config.DefaultTerragruntConfigPath))
+	require.NoError(t, err)
+
+	opts.WorkingDir = workingDir
+	opts.AppendReadFile(filepath.Join(workingDir, "common", "sizes.yaml"), db.Path)
+
+	tt := []struct {
+		name     string
+		filters  []string
+		expected discovery.DiscoveredConfigs
+	}{
+		{name: "no filters", expected: cfgs},
+		{name: "path", filters: []string{"live/prod/*"}, expected: discovery.DiscoveredConfigs{vpc, db, app}},
+		{name: "recursive path", filters: []string{"**/db"}, expected: discovery.DiscoveredConfigs{db}},
+		{name: "type", filters: []string{"type=stack"}, expected: discovery.DiscoveredConfigs{stack}},
+		{name: "reads include", filters: []string{"reads=root.hcl"}, expected: discovery.DiscoveredConfigs{vpc}},
+		{name: "reads file", filters: []string{"reads=common/*.yaml"}, expected: discovery.DiscoveredConfigs{db}},
+		{name: "source", filters: []string{"source=*acme/modules.git//vpc*"}, expected: discovery.DiscoveredConfigs{vpc}},
+		{name: "exclude", filters: []string{"exclude=destroy"}, expected: discovery.DiscoveredConfigs{db}},
+		{name: "feature", filters: []string{"feature=enable_*"}, expected: discovery.DiscoveredConfigs{db}},
+		{name: "depends on", filters: []string{"depends-on=live/prod/vpc"}, expected: discovery.DiscoveredConfigs{db, app}},
+		{name: "dependency of", filters: []string{"dependency-of=live/prod/app"}, expected: discovery.DiscoveredConfigs{vpc, db}},
+		{name: "not", filters: []string{"!type=unit"}, expected: discovery.DiscoveredConfigs{stack}},
+		{name: "and", filters: []string{"type=unit & !depends-on=**"}, expected: discovery.DiscoveredConfigs{vpc}},
+		{name: "or", filters: []string{"**/app | dependency-of=**/db"}, expected: discovery.DiscoveredConfigs{vpc, app}},
+		{name: "multiple filters", filters: []string{"**/app", "type=stack"}, expected: discovery.DiscoveredConfigs{app, stack}},
+		{name: "precedence", filters: []string{"**/app | **/db & exclude=plan"}, expected: discovery.DiscoveredConfigs{app}},
+		{name: "parentheses", filters: []string{"(**/app | **/db) & exclude=destroy"}, expected: discovery.DiscoveredConfigs{db}},
+		{name: "no match", filters: []string{"live/dev/**"}, expected: discovery.DiscoveredConfigs{}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			filters, err := filter.ParseAll(tc.filters)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected.Paths(), filters.Select(opts, cfgs).Paths())
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	tokenWord tokenKind = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenEquals
+	tokenOpen
+	tokenClose
+	tokenEnd
+)
+
+// tokenKind is the kind of a token of a filter expression.
+type tokenKind int
+
+// token is a token of a filter expression, with its position in the expression.
+type token struct {
+	value string
+	kind  tokenKind
+	pos   int
+}
+
+// operators are the characters with a meaning in filter expressions, which can only be used in quoted words.
+var operators = map[rune]tokenKind{
+	'&': tokenAnd,
+	'|': tokenOr,
+	'!': tokenNot,
+	'=': tokenEquals,
+	'(': tokenOpen,
+	')': tokenClose,
+}
+
+// tokenize splits the filter expression into tokens.
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+
+	runes := []rune(source)
+
+	for pos := 0; pos < len(runes); {
+		r := runes[pos]
+
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+		case isOperator(r):
+			tokens = append(tokens, token{kind: operators[r], value: string(r), pos: pos})
+			pos++
+		case r == '"' || r == '\'':
+			end := pos + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated quoted string at position %d", pos+1)
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[pos+1 : end]), pos: pos})
+			pos = end + 1
+		default:
+			end := pos
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !isOperator(runes[end]) && runes[end] != '"' && runes[end] != '\'' {
+				end++
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[pos:end]), pos: pos})
+			pos = end
+		}
+	}
+
+	return append(tokens, token{kind: tokenEnd, pos: len(runes)}), nil
+}
+
+func isOperator(r rune) bool {
+	_, ok := operators[r]
+
+	return ok
+}
+
+// parser is a recursive descent parser of filter expressions:
+//
+//	expression = and { "|" and }
+//	and        = unary { "&" unary }
+//	unary      = "!" unary | "(" expression ")" | predicate
+//	predicate  = word [ "=" word ]
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+
+	if tok.kind != tokenEnd {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser) parseExpression() (expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &orExpression{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &andExpression{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expression, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenNot:
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &notExpression{operand: operand}, nil
+	case tokenOpen:
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokenClose {
+			return nil, unexpected(closing, "')'")
+		}
+
+		return expr, nil
+	case tokenWord:
+		if p.peek().kind != tokenEquals {
+			return newPredicate(AttributePath, tok.value)
+		}
+
+		p.next()
+
+		value := p.next()
+		if value.kind != tokenWord {
+			return nil, unexpected(value, "a value")
+		}
+
+		return newPredicate(Attribute(strings.ToLower(tok.value)), value.value)
+	default:
+		return nil, unexpected(tok, "a predicate")
+	}
+}
+
+func unexpected(tok token, expected string) error {
+	if tok.kind == tokenEnd {
+		return fmt.Errorf("expected %s at the end of the expression", expected)
+	}
+
+	return fmt.Errorf("expected %s at position %d, found %q", expected, tok.pos+1, tok.value)
+}
@@ -20,9 +20,11 @@ import (
 type Cmd struct {
 	logger          log.Logger
 	interruptSignal os.Signal
+	terminateSignal os.Signal
 	*exec.Cmd
 	filename           string
 	forwardSignalDelay time.Duration
+	terminateDelay     time.Duration
 	usePTY             bool
 }
 
@@ -34,6 +36,7 @@ func Command(name string, args ...string) *Cmd {
 		logger:          log.Default(),
 		filename:        filepath.Base(name),
 		interruptSignal: signal.InterruptSignal,
+		terminateSignal: signal.TerminateSignal,
 	}
 
 	cmd.Stdin = os.Stdin
@@ -70,8 +73,9 @@ func (cmd *Cmd) Start() error {
 //     since our executed command may also receive the same signal, we need to give the command time to gracefully shutting down,
 //     to avoid the command receiving this signal twice.
 //     Thus we will send the signal to the executed command with a delay or immediately if Terragrunt receives this same signal again.
-//  2. If the context does not contain any causes, this means that there was some failure and we need to terminate all executed commands,
+//  2. If the context does not contain any causes, this means that there was some failure, or a timeout, and we need to terminate all executed commands,
 //     in this situation we are sure that commands did not receive any signal, so we send them an interrupt signal immediately.
+//     If cmd.terminateDelay is greater than 0, and the command is still running after that delay, we send it the terminate signal.
 func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 	ctxShutdown, cancelShutdown := context.WithCancel(context.Background())
 
@@ -86,12 +90,34 @@ func (cmd *Cmd) RegisterGracefullyShutdown(ctx context.Context) func() {
 			}
 
 			cmd.SendSignal(cmd.interruptSignal)
+
+			if cmd.terminateDelay > 0 {
+				cmd.terminateAfterDelay(ctxShutdown)
+			}
 		}
 	}()
 
 	return cancelShutdown
 }
 
+// terminateAfterDelay sends the terminate signal to the executed command, unless the given `ctx` becomes `Done`
+// within cmd.terminateDelay, meaning the command exited.
+func (cmd *Cmd) terminateAfterDelay(ctx context.Context) {
+	cmd.logger.Debugf("%s signal will be sent to %s if it is still running in %s",
+		cases.Title(language.English).String(cmd.terminateSignal.String()),
+		cmd.filename,
+		cmd.terminateDelay,
+	)
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(cmd.terminateDelay):
+	}
+
+	cmd.SendSignal(cmd.terminateSignal)
+}
+
 // ForwardSignal forwards a given `sig` with a delay if cmd.forwardSignalDelay is greater than 0,
 // and if the same signal is received again, it is forwarded immediately.
 func (cmd *Cmd) ForwardSignal(ctx context.Context, sig os.Signal) {
@@ -4,6 +4,7 @@
 package exec_test
 
 import (
+	"context"
 	"errors"
 	"os"
 	"strconv"
@@ -73,6 +74,32 @@ func TestNewSignalsForwarderWaitUnix(t *testing.T) {
 		"Expected to wait 5 (+/-1) seconds after SIGINT")
 }
 
+func TestTerminateAfterDelayUnix(t *testing.T) {
+	t.Parallel()
+
+	terminateDelay := 2 * time.Second
+
+	cmd := exec.Command("testdata/test_sigint_ignore.sh")
+	cmd.Configure(exec.WithTerminateDelay(terminateDelay))
+
+	require.NoError(t, cmd.Start())
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	cancelShutdown := cmd.RegisterGracefullyShutdown(ctx)
+	defer cancelShutdown()
+
+	time.Sleep(time.Second)
+	start := time.Now()
+	cancel()
+
+	err := cmd.Wait()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "terminated", "Expected the subprocess to be terminated after ignoring SIGINT")
+	assert.WithinDuration(t, time.Now(), start.Add(terminateDelay), time.Second,
+		"Expected to send SIGTERM 2 (+/-1) seconds after SIGINT")
+}
+
 // There isn't a proper way to catch interrupts in Windows batch scripts, so this test exists only for Unix.
 func TestNewSignalsForwarderMultipleUnix(t *testing.T) {
 	t.Parallel()
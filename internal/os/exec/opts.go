@@ -33,6 +33,14 @@ func WithEnv(env map[string]string) Option {
 	}
 }
 
+// WithTerminateDelay sets the delay after which the terminate signal is sent to the Cmd, if it is still running after
+// it was interrupted because its context was done.
+func WithTerminateDelay(delay time.Duration) Option {
+	return func(cmd *Cmd) {
+		cmd.terminateDelay = delay
+	}
+}
+
 // WithForwardSignalDelay sets forwarding signal delay to the Cmd.
 func WithForwardSignalDelay(delay time.Duration) Option {
 	return func(cmd *Cmd) {
@@ -0,0 +1,5 @@
+#!/bin/bash -e
+
+trap '' INT
+
+while true; do sleep 0.1; done
@@ -11,5 +11,8 @@ import (
 // InterruptSignal is an interrupt signal.
 var InterruptSignal = syscall.SIGINT //nolint:gochecknoglobals
 
+// TerminateSignal is the signal sent to a command that did not exit after the interrupt signal.
+var TerminateSignal os.Signal = syscall.SIGTERM //nolint:gochecknoglobals
+
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT} //nolint:gochecknoglobals
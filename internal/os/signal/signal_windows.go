@@ -10,5 +10,8 @@ import (
 // InterruptSignal is an interrupt signal.
 var InterruptSignal os.Signal = nil
 
+// TerminateSignal is the signal sent to a command that did not exit after the interrupt signal.
+var TerminateSignal os.Signal = os.Kill
+
 // InterruptSignals contains a list of signals that are treated as interrupts.
 var InterruptSignals []os.Signal = []os.Signal{}
@@ -0,0 +1,535 @@
+// Package parsecache provides an on-disk cache of the results of the partial parses of Terragrunt configurations,
+// reused across runs of Terragrunt.
+//
+// Each entry records the inputs of the parse: the SHA256 hashes of the configuration file, the files it includes and
+// reads, and of the files looked up that don't exist, and the hashes of the values of the environment variables it
+// reads. An entry is only reused if all of its inputs are unchanged. Parses that call impure functions, like
+// `run_cmd` or `get_aws_account_id`, or that read the outputs of dependencies, are never cached.
+//
+// Only the parts of the configuration used to discover units and build the run queue are cached: the dependencies,
+// the `exclude` block, the processed includes, the source of the `terraform` block, the `concurrency` block, the
+// feature flags, and the `errors` block.
+package parsecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	// DirName is the name of the directory of the cache in the user cache directory.
+	DirName = "parse-cache"
+
+	// entryFormat is the version of the format of the entries, part of their keys.
+	entryFormat = "2"
+
+	// absentHash is the hash of a file that doesn't exist, or of an environment variable that is not set.
+	absentHash = ""
+
+	// dirHash is the hash of a path that is a directory.
+	dirHash = "dir"
+)
+
+// cacheableSections are the sections of a partial parse whose results are stored in the entries.
+var cacheableSections = []config.PartialDecodeSectionType{
+	config.DependenciesBlock,
+	config.DependencyBlock,
+	config.ExcludeBlock,
+	config.FeatureFlagsBlock,
+	config.TerraformSource,
+	config.ConcurrencyBlock,
+	config.ErrorsBlock,
+}
+
+// ParseFunc parses a configuration in the given parsing context.
+type ParseFunc func(ctx *config.ParsingContext) (*config.TerragruntConfig, error)
+
+// Cache is the on-disk cache of the results of partial parses. A nil cache is disabled.
+type Cache struct {
+	dir string
+}
+
+// New returns the cache stored in the given directory.
+func New(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// DefaultDir returns the default directory of the cache, in the user cache directory.
+func DefaultDir() (string, error) {
+	cacheDir, err := util.GetCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, DirName), nil
+}
+
+// FromOptions returns the cache configured by `--parse-cache` and `--parse-cache-dir`, or nil if the cache is
+// disabled or its directory can't be determined.
+func FromOptions(l log.Logger, opts *options.TerragruntOptions) *Cache {
+	if !opts.ParseCache {
+		return nil
+	}
+
+	dir := opts.ParseCacheDir
+	if dir == "" {
+		defaultDir, err := DefaultDir()
+		if err != nil {
+			l.Warnf("Disabling the parse cache, failed to find the user cache directory: %v", err)
+			return nil
+		}
+
+		dir = defaultDir
+	}
+
+	return New(dir)
+}
+
+// Dir returns the directory of the cache.
+func (cache *Cache) Dir() string {
+	return cache.dir
+}
+
+// Clean removes all the entries of the cache.
+func (cache *Cache) Clean() error {
+	if err := os.RemoveAll(cache.dir); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// Parse returns the cached result of the partial parse of the configuration at `configPath` if its inputs did not
+// change, or parses it with `parse` and caches the result. The files read by the units during a cached parse are
+// added to `TerragruntOptions.ReadFiles`, as if the configuration was parsed.
+func (cache *Cache) Parse(ctx *config.ParsingContext, l log.Logger, configPath string, include *config.IncludeConfig, parse ParseFunc) (*config.TerragruntConfig, error) {
+	if cache == nil || !isCacheable(ctx.PartialParseDecodeList) {
+		return parse(ctx)
+	}
+
+	opts := ctx.TerragruntOptions
+	entryPath := cache.entryPath(key(opts, configPath, include, ctx.PartialParseDecodeList))
+
+	if entry, err := readEntry(entryPath); err == nil && entry.isValid(opts) {
+		l.Debugf("Parse cache hit for %s", configPath)
+
+		for _, readFile := range entry.ReadFiles {
+			opts.AppendReadFile(readFile.Path, readFile.Unit)
+		}
+
+		return entry.Config.toConfig(), nil
+	}
+
+	l.Debugf("Parse cache miss for %s", configPath)
+
+	inputs := config.NewParseInputs()
+
+	parseCtx := *ctx
+	parseCtx.Context = config.ContextWithParseInputs(ctx.Context, inputs)
+
+	cfg, err := parse(&parseCtx)
+	if err != nil {
+		return cfg, err
+	}
+
+	if impure := inputs.Impure(); len(impure) > 0 {
+		l.Debugf("Not caching the parse of %s, it calls %s", configPath, strings.Join(impure, ", "))
+
+		return cfg, nil
+	}
+
+	entry, err := newEntry(opts, inputs, cfg)
+	if err == nil {
+		err = writeEntry(entryPath, entry)
+	}
+
+	if err != nil {
+		l.Debugf("Not caching the parse of %s: %v", configPath, err)
+	}
+
+	return cfg, nil
+}
+
+// entryPath returns the path of the entry with the given key.
+func (cache *Cache) entryPath(key string) string {
+	return filepath.Join(cache.dir, key[:2], key+".json")
+}
+
+// isCacheable returns true if the results of all the sections of the decode list are stored in the entries.
+func isCacheable(decodeList []config.PartialDecodeSectionType) bool {
+	if len(decodeList) == 0 {
+		return false
+	}
+
+	for _, section := range decodeList {
+		if !slices.Contains(cacheableSections, section) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// key returns the key of the entry of the parse, made of everything the parse depends on besides its recorded inputs.
+func key(opts *options.TerragruntOptions, configPath string, include *config.IncludeConfig, decodeList []config.PartialDecodeSectionType) string {
+	hash := sha256.New()
+
+	fmt.Fprintf(hash, "format:%s\n", entryFormat)
+	fmt.Fprintf(hash, "os:%s\n", runtime.GOOS)
+	fmt.Fprintf(hash, "config:%s\n", configPath)
+	fmt.Fprintf(hash, "include:%s\n", include.String())
+	fmt.Fprintf(hash, "decode:%v\n", decodeList)
+	fmt.Fprintf(hash, "working-dir:%s\n", opts.WorkingDir)
+	fmt.Fprintf(hash, "original-config:%s\n", opts.OriginalTerragruntConfigPath)
+
+	if opts.TerragruntVersion != nil {
+		fmt.Fprintf(hash, "version:%s\n", opts.TerragruntVersion.String())
+	}
+
+	if opts.FeatureFlags != nil {
+		var flags []string
+
+		opts.FeatureFlags.Range(func(name, value string) bool {
+			flags = append(flags, name+"="+value)
+			return true
+		})
+
+		slices.Sort(flags)
+
+		fmt.Fprintf(hash, "feature:%s\n", strings.Join(flags, ","))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// entry is a cached result of a partial parse, with the hashes of its inputs.
+type entry struct {
+	// Files are the hashes of the files the parse depends on, by path.
+	Files map[string]string `json:"files"`
+	// Env are the hashes of the values of the environment variables the parse depends on, by name.
+	Env map[string]string `json:"env,omitempty"`
+	// ReadFiles are the files read by the units with HCL functions during the parse.
+	ReadFiles []config.ReadFile `json:"read_files,omitempty"`
+	// Config is the result of the parse.
+	Config *cachedConfig `json:"config"`
+}
+
+// newEntry returns the entry of the result of the parse, with the current hashes of its recorded inputs.
+func newEntry(opts *options.TerragruntOptions, inputs *config.ParseInputs, cfg *config.TerragruntConfig) (*entry, error) {
+	cached, err := newCachedConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &entry{
+		Files:     make(map[string]string),
+		Env:       make(map[string]string),
+		ReadFiles: inputs.ReadFiles(),
+		Config:    cached,
+	}
+
+	for _, path := range inputs.Files() {
+		hash, err := hashFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.Files[path] = hash
+	}
+
+	for _, name := range inputs.Env() {
+		entry.Env[name] = hashEnv(opts, name)
+	}
+
+	return entry, nil
+}
+
+// isValid returns true if none of the inputs of the entry changed.
+func (entry *entry) isValid(opts *options.TerragruntOptions) bool {
+	if entry.Config == nil {
+		return false
+	}
+
+	for name, hash := range entry.Env {
+		if hashEnv(opts, name) != hash {
+			return false
+		}
+	}
+
+	for path, hash := range entry.Files {
+		if current, err := hashFile(path); err != nil || current != hash {
+			return false
+		}
+	}
+
+	return true
+}
+
+func readEntry(path string) (*entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	entry := new(entry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, errors.New(err)
+	}
+
+	return entry, nil
+}
+
+// writeEntry writes the entry to a temporary file renamed to `path`, so concurrent runs never read partial entries.
+func writeEntry(path string, entry *entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return errors.New(err)
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "*.tmp")
+	if err != nil {
+		return errors.New(err)
+	}
+
+	defer os.Remove(tmpFile.Name()) //nolint:errcheck
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close() //nolint:errcheck,gosec
+
+		return errors.New(err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return errors.New(err)
+	}
+
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return errors.New(err)
+	}
+
+	return nil
+}
+
+// hashFile returns the SHA256 hash of the content of the file, absentHash if it doesn't exist, or dirHash if it is a
+// directory.
+func hashFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return absentHash, nil
+	}
+
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	if info.IsDir() {
+		return dirHash, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// hashEnv returns the SHA256 hash of the value of the environment variable, or absentHash if it is not set. Only the
+// hash is stored, as the values may be secrets.
+func hashEnv(opts *options.TerragruntOptions, name string) string {
+	value, ok := opts.Env[name]
+	if !ok {
+		return absentHash
+	}
+
+	sum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// cachedConfig is the part of a partially parsed configuration stored in the entries.
+type cachedConfig struct {
+	Dependencies      *config.ModuleDependencies `json:"dependencies,omitempty"`
+	Exclude           *config.ExcludeConfig      `json:"exclude,omitempty"`
+	TerraformSource   *string                    `json:"terraform_source,omitempty"`
+	Concurrency       *config.ConcurrencyConfig  `json:"concurrency,omitempty"`
+	Errors            *cachedErrors              `json:"errors,omitempty"`
+	ProcessedIncludes config.IncludeConfigsMap   `json:"processed_includes,omitempty"`
+	DependencyBlocks  []cachedDependency         `json:"dependency_blocks,omitempty"`
+	FeatureFlags      []cachedFeatureFlag        `json:"feature_flags,omitempty"`
+	IsPartial         bool                       `json:"is_partial"`
+}
+
+// cachedDependency is a `dependency` block, without its mock outputs, which are not needed to build the run queue.
+type cachedDependency struct {
+	Enabled    *bool  `json:"enabled,omitempty"`
+	Name       string `json:"name"`
+	ConfigPath string `json:"config_path"`
+}
+
+// cachedFeatureFlag is a `feature` block. Its default value is stored without its type, which is implied again from
+// the JSON value when it is read.
+type cachedFeatureFlag struct {
+	Default *ctyjson.SimpleJSONValue `json:"default,omitempty"`
+	Name    string                   `json:"name"`
+}
+
+// cachedErrors is the `errors` block.
+type cachedErrors struct {
+	Retry  []*config.RetryBlock `json:"retry,omitempty"`
+	Ignore []cachedIgnoreBlock  `json:"ignore,omitempty"`
+}
+
+// cachedIgnoreBlock is an `ignore` block of the `errors` block, whose signals are stored without their types.
+type cachedIgnoreBlock struct {
+	Signals         map[string]ctyjson.SimpleJSONValue `json:"signals,omitempty"`
+	Label           string                             `json:"label"`
+	Message         string                             `json:"message,omitempty"`
+	IgnorableErrors []string                           `json:"ignorable_errors,omitempty"`
+}
+
+func newCachedConfig(cfg *config.TerragruntConfig) (*cachedConfig, error) {
+	cached := &cachedConfig{
+		Dependencies:      cfg.Dependencies,
+		Exclude:           cfg.Exclude,
+		Concurrency:       cfg.Concurrency,
+		ProcessedIncludes: cfg.ProcessedIncludes,
+		IsPartial:         cfg.IsPartial,
+	}
+
+	if cfg.Terraform != nil {
+		cached.TerraformSource = cfg.Terraform.Source
+	}
+
+	for _, dep := range cfg.TerragruntDependencies {
+		if !dep.ConfigPath.IsWhollyKnown() || dep.ConfigPath.IsNull() || dep.ConfigPath.Type() != cty.String {
+			return nil, errors.Errorf("the config_path of dependency %q is not a known string", dep.Name)
+		}
+
+		cached.DependencyBlocks = append(cached.DependencyBlocks, cachedDependency{
+			Name:       dep.Name,
+			ConfigPath: dep.ConfigPath.AsString(),
+			Enabled:    dep.Enabled,
+		})
+	}
+
+	for _, flag := range cfg.FeatureFlags {
+		cachedFlag := cachedFeatureFlag{Name: flag.Name}
+
+		if flag.Default != nil {
+			if !flag.Default.IsWhollyKnown() {
+				return nil, errors.Errorf("the default value of feature flag %q is not known", flag.Name)
+			}
+
+			cachedFlag.Default = &ctyjson.SimpleJSONValue{Value: *flag.Default}
+		}
+
+		cached.FeatureFlags = append(cached.FeatureFlags, cachedFlag)
+	}
+
+	if cfg.Errors != nil {
+		cached.Errors = &cachedErrors{Retry: cfg.Errors.Retry}
+
+		for _, ignore := range cfg.Errors.Ignore {
+			cachedIgnore := cachedIgnoreBlock{
+				Label:           ignore.Label,
+				Message:         ignore.Message,
+				IgnorableErrors: ignore.IgnorableErrors,
+			}
+
+			for name, signal := range ignore.Signals {
+				if !signal.IsWhollyKnown() {
+					return nil, errors.Errorf("the signal %q of ignore block %q is not known", name, ignore.Label)
+				}
+
+				if cachedIgnore.Signals == nil {
+					cachedIgnore.Signals = make(map[string]ctyjson.SimpleJSONValue)
+				}
+
+				cachedIgnore.Signals[name] = ctyjson.SimpleJSONValue{Value: signal}
+			}
+
+			cached.Errors.Ignore = append(cached.Errors.Ignore, cachedIgnore)
+		}
+	}
+
+	return cached, nil
+}
+
+func (cached *cachedConfig) toConfig() *config.TerragruntConfig {
+	cfg := &config.TerragruntConfig{
+		Dependencies:      cached.Dependencies,
+		Exclude:           cached.Exclude,
+		Concurrency:       cached.Concurrency,
+		ProcessedIncludes: cached.ProcessedIncludes,
+		IsPartial:         cached.IsPartial,
+	}
+
+	if cached.TerraformSource != nil {
+		cfg.Terraform = &config.TerraformConfig{Source: cached.TerraformSource}
+	}
+
+	for _, dep := range cached.DependencyBlocks {
+		cfg.TerragruntDependencies = append(cfg.TerragruntDependencies, config.Dependency{
+			Name:       dep.Name,
+			ConfigPath: cty.StringVal(dep.ConfigPath),
+			Enabled:    dep.Enabled,
+		})
+	}
+
+	for _, flag := range cached.FeatureFlags {
+		featureFlag := &config.FeatureFlag{Name: flag.Name}
+
+		if flag.Default != nil {
+			featureFlag.Default = &flag.Default.Value
+		}
+
+		cfg.FeatureFlags = append(cfg.FeatureFlags, featureFlag)
+	}
+
+	if cached.Errors != nil {
+		cfg.Errors = &config.ErrorsConfig{Retry: cached.Errors.Retry}
+
+		for _, ignore := range cached.Errors.Ignore {
+			ignoreBlock := &config.IgnoreBlock{
+				Label:           ignore.Label,
+				Message:         ignore.Message,
+				IgnorableErrors: ignore.IgnorableErrors,
+			}
+
+			for name, signal := range ignore.Signals {
+				if ignoreBlock.Signals == nil {
+					ignoreBlock.Signals = make(map[string]cty.Value)
+				}
+
+				ignoreBlock.Signals[name] = signal.Value
+			}
+
+			cfg.Errors.Ignore = append(cfg.Errors.Ignore, ignoreBlock)
+		}
+	}
+
+	return cfg
+}
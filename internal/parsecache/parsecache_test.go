@@ -0,0 +1,254 @@
+package parsecache_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/config"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/filter"
+	"github.com/gruntwork-io/terragrunt/internal/parsecache"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testParse parses the unit with the cache, and returns the parsed configuration, whether the configuration was
+// actually parsed, and the options used to parse it. The dependencies and the `exclude` block are decoded, unless other
+// sections are given.
+func testParse(t *testing.T, cache *parsecache.Cache, unitDir string, env map[string]string, sections ...config.PartialDecodeSectionType) (*config.TerragruntConfig, bool, *options.TerragruntOptions) {
+	t.Helper()
+
+	if len(sections) == 0 {
+		sections = []config.PartialDecodeSectionType{config.DependenciesBlock, config.DependencyBlock, config.ExcludeBlock}
+	}
+
+	configPath := filepath.Join(unitDir, config.DefaultTerragruntConfigPath)
+
+	opts, err := options.NewTerragruntOptionsForTest(configPath)
+	require.NoError(t, err)
+
+	opts.WorkingDir = unitDir
+	opts.Env = env
+
+	l := logger.CreateLogger()
+	ctx := config.NewParsingContext(config.WithConfigValues(t.Context()), l, opts).
+		WithDecodeList(sections...)
+
+	parsed := false
+
+	cfg, err := cache.Parse(ctx, l, configPath, nil, func(ctx *config.ParsingContext) (*config.TerragruntConfig, error) {
+		parsed = true
+
+		return config.PartialParseConfigFile(ctx, l, configPath, nil)
+	})
+	require.NoError(t, err)
+
+	return cfg, parsed, opts
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestParseCacheHitAndInvalidation(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+	unitDir := filepath.Join(rootDir, "live", "app")
+	cache := parsecache.New(t.TempDir())
+
+	writeFile(t, filepath.Join(rootDir, "root.hcl"), `locals { vpc = "../vpc" }`)
+	writeFile(t, filepath.Join(unitDir, "deps.json"), `{"db": "../db"}`)
+	writeFile(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath), `
+include "root" {
+  path   = find_in_parent_folders("root.hcl")
+  expose = true
+}
+
+locals {
+  deps = jsondecode(read_tfvars_file("deps.json"))
+}
+
+dependency "vpc" {
+  config_path = include.root.locals.vpc
+}
+
+dependencies {
+  paths = [local.deps.db, get_env("EXTRA_DEPENDENCY", "../default")]
+}
+
+exclude {
+  if      = true
+  actions = ["destroy"]
+}
+`)
+
+	env := map[string]string{}
+
+	cfg, parsed, _ := testParse(t, cache, unitDir, env)
+	assert.True(t, parsed)
+	assert.Equal(t, []string{"../db", "../default", "../vpc"}, cfg.Dependencies.Paths)
+
+	// Nothing changed, the cached result is used, and the files read by the unit are recorded as if it was parsed.
+	cached, parsed, opts := testParse(t, cache, unitDir, env)
+	assert.False(t, parsed)
+	assert.Equal(t, cfg.Dependencies, cached.Dependencies)
+	assert.Equal(t, cfg.Exclude, cached.Exclude)
+	assert.Equal(t, cfg.ProcessedIncludes, cached.ProcessedIncludes)
+	require.Len(t, cached.TerragruntDependencies, 1)
+	assert.Equal(t, "vpc", cached.TerragruntDependencies[0].Name)
+	assert.Equal(t, "../vpc", cached.TerragruntDependencies[0].ConfigPath.AsString())
+	assert.True(t, opts.DidReadFile(filepath.Join(unitDir, "deps.json"), unitDir))
+
+	// A change of a read file.
+	writeFile(t, filepath.Join(unitDir, "deps.json"), `{"db": "../rds"}`)
+
+	cfg, parsed, _ = testParse(t, cache, unitDir, env)
+	assert.True(t, parsed)
+	assert.Equal(t, []string{"../rds", "../default", "../vpc"}, cfg.Dependencies.Paths)
+
+	// A change of an environment variable.
+	env["EXTRA_DEPENDENCY"] = "../cache"
+
+	cfg, parsed, _ = testParse(t, cache, unitDir, env)
+	assert.True(t, parsed)
+	assert.Equal(t, []string{"../rds", "../cache", "../vpc"}, cfg.Dependencies.Paths)
+
+	// A change of an included file.
+	writeFile(t, filepath.Join(rootDir, "root.hcl"), `locals { vpc = "../network" }`)
+
+	cfg, parsed, _ = testParse(t, cache, unitDir, env)
+	assert.True(t, parsed)
+	assert.Equal(t, "../network", cfg.TerragruntDependencies[0].ConfigPath.AsString())
+
+	// A new file found by `find_in_parent_folders` before the included one.
+	writeFile(t, filepath.Join(rootDir, "live", "root.hcl"), `locals { vpc = "../live-vpc" }`)
+
+	cfg, parsed, _ = testParse(t, cache, unitDir, env)
+	assert.True(t, parsed)
+	assert.Equal(t, "../live-vpc", cfg.TerragruntDependencies[0].ConfigPath.AsString())
+
+	_, parsed, _ = testParse(t, cache, unitDir, env)
+	assert.False(t, parsed)
+}
+
+func TestParseCacheFeatureFlagsAndErrors(t *testing.T) {
+	t.Parallel()
+
+	unitDir := t.TempDir()
+	cache := parsecache.New(t.TempDir())
+
+	writeFile(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath), `
+feature "beta" {
+  default = false
+}
+
+feature "replicas" {
+  default = 2
+}
+
+errors {
+  retry "network" {
+    retryable_errors   = [".*timeout.*"]
+    max_attempts       = 3
+    sleep_interval_sec = 5
+  }
+
+  ignore "known" {
+    ignorable_errors = [".*already exists.*"]
+    message          = "Already exists"
+    signals = {
+      safe_to_revert = true
+    }
+  }
+}
+`)
+
+	sections := []config.PartialDecodeSectionType{config.DependenciesBlock, config.FeatureFlagsBlock, config.ErrorsBlock}
+
+	cfg, parsed, opts := testParse(t, cache, unitDir, map[string]string{}, sections...)
+	assert.True(t, parsed)
+
+	cached, parsed, _ := testParse(t, cache, unitDir, map[string]string{}, sections...)
+	assert.False(t, parsed)
+
+	require.Len(t, cached.FeatureFlags, 2)
+
+	for i, flag := range cfg.FeatureFlags {
+		assert.Equal(t, flag.Name, cached.FeatureFlags[i].Name)
+		assert.True(t, flag.Default.RawEquals(*cached.FeatureFlags[i].Default), flag.Name)
+	}
+
+	require.NotNil(t, cached.Errors)
+	assert.Equal(t, cfg.Errors.Retry, cached.Errors.Retry)
+	require.Len(t, cached.Errors.Ignore, 1)
+	assert.Equal(t, cfg.Errors.Ignore[0].IgnorableErrors, cached.Errors.Ignore[0].IgnorableErrors)
+	assert.Equal(t, "Already exists", cached.Errors.Ignore[0].Message)
+	assert.True(t, cached.Errors.Ignore[0].Signals["safe_to_revert"].True())
+
+	// The feature filter of `find` matches the cached configuration the same way as the parsed one.
+	filters, err := filter.ParseAll([]string{"feature=beta"})
+	require.NoError(t, err)
+
+	unit := &discovery.DiscoveredConfig{Type: discovery.ConfigTypeUnit, Path: unitDir, Parsed: cached}
+	assert.Equal(t, discovery.DiscoveredConfigs{unit}, filters.Select(opts, discovery.DiscoveredConfigs{unit}))
+}
+
+func TestParseCacheSkipsImpureParses(t *testing.T) {
+	t.Parallel()
+
+	unitDir := t.TempDir()
+	cache := parsecache.New(t.TempDir())
+
+	writeFile(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath), `
+dependencies {
+  paths = [timestamp()]
+}
+`)
+
+	_, parsed, _ := testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+
+	_, parsed, _ = testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+}
+
+func TestParseCacheClean(t *testing.T) {
+	t.Parallel()
+
+	unitDir := t.TempDir()
+	cache := parsecache.New(t.TempDir())
+
+	writeFile(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath), `dependencies { paths = ["../vpc"] }`)
+
+	_, parsed, _ := testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+
+	require.NoError(t, cache.Clean())
+	assert.NoDirExists(t, cache.Dir())
+
+	_, parsed, _ = testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+}
+
+func TestParseCacheDisabled(t *testing.T) {
+	t.Parallel()
+
+	unitDir := t.TempDir()
+
+	writeFile(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath), `dependencies { paths = ["../vpc"] }`)
+
+	var cache *parsecache.Cache
+
+	_, parsed, _ := testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+
+	_, parsed, _ = testParse(t, cache, unitDir, map[string]string{})
+	assert.True(t, parsed)
+}
@@ -0,0 +1,165 @@
+package queue
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+)
+
+const (
+	// OrderCriticalPath starts the units with the longest chain of units waiting on them first.
+	OrderCriticalPath = "critical-path"
+	// OrderAlphabetical starts the units in alphabetical order of their paths.
+	OrderAlphabetical = "alphabetical"
+	// OrderRandom starts the units in a random order.
+	OrderRandom = "random"
+)
+
+// OrderNames are the names of the orders accepted by NewOrder.
+var OrderNames = []string{OrderCriticalPath, OrderAlphabetical, OrderRandom}
+
+// Order is a strategy for the order in which units that are ready to run start.
+type Order interface {
+	// Ranks returns the rank of each of the given configurations, keyed by path. Ready units with a lower rank start
+	// first, and no two configurations have the same rank.
+	Ranks(discovered discovery.DiscoveredConfigs) map[string]int
+}
+
+// NewOrder returns the order with the given name, or the alphabetical order if the name is empty. The durations of
+// the units, keyed by path, are used to weight the critical path order, and may be nil.
+func NewOrder(name string, durations map[string]time.Duration) (Order, error) {
+	switch name {
+	case "", OrderAlphabetical:
+		return AlphabeticalOrder{}, nil
+	case OrderCriticalPath:
+		return CriticalPathOrder{Durations: durations}, nil
+	case OrderRandom:
+		return RandomOrder{}, nil
+	}
+
+	return nil, fmt.Errorf("invalid queue order %q, expected one of: %s", name, strings.Join(OrderNames, ", "))
+}
+
+// AlphabeticalOrder ranks units in alphabetical order of their paths.
+type AlphabeticalOrder struct{}
+
+// Ranks implements Order.
+func (AlphabeticalOrder) Ranks(discovered discovery.DiscoveredConfigs) map[string]int {
+	return ranksOf(slices.Clone(discovered).Sort())
+}
+
+// RandomOrder ranks units in a random order.
+type RandomOrder struct {
+	// Rand is the source of randomness, the global one if nil.
+	Rand *rand.Rand
+}
+
+// Ranks implements Order.
+func (order RandomOrder) Ranks(discovered discovery.DiscoveredConfigs) map[string]int {
+	configs := slices.Clone(discovered).Sort()
+
+	shuffle := rand.Shuffle
+	if order.Rand != nil {
+		shuffle = order.Rand.Shuffle
+	}
+
+	shuffle(len(configs), func(i, j int) {
+		configs[i], configs[j] = configs[j], configs[i]
+	})
+
+	return ranksOf(configs)
+}
+
+// CriticalPathOrder ranks units by the length of their critical path: the longest chain of units that can only start
+// once the unit is done, including the unit itself. For "up" commands these are the dependents of the unit, and for
+// "down" commands its dependencies. Starting the units with the longest critical path first keeps the deepest chains
+// of a stack from being delayed by units nothing waits on.
+//
+// The length of a chain is the sum of the durations of its units. Units without a duration are assumed to take the
+// average duration of the others, and every unit counts the same if no duration is given.
+type CriticalPathOrder struct {
+	Durations map[string]time.Duration
+}
+
+// Ranks implements Order.
+func (order CriticalPathOrder) Ranks(discovered discovery.DiscoveredConfigs) map[string]int {
+	configs := slices.Clone(discovered).Sort()
+	weights := unitWeights(configs, order.Durations)
+
+	byPath := make(map[string]*discovery.DiscoveredConfig, len(configs))
+	for _, cfg := range configs {
+		byPath[cfg.Path] = cfg
+	}
+
+	// waiting lists, for each unit, the units that can only start once it is done.
+	waiting := make(map[string][]string, len(configs))
+
+	for _, cfg := range configs {
+		up := (&Entry{Config: cfg}).IsUp()
+
+		for _, dep := range cfg.Dependencies {
+			if _, ok := byPath[dep.Path]; !ok {
+				continue
+			}
+
+			if up {
+				waiting[dep.Path] = append(waiting[dep.Path], cfg.Path)
+			} else {
+				waiting[cfg.Path] = append(waiting[cfg.Path], dep.Path)
+			}
+		}
+	}
+
+	lengths := make(map[string]time.Duration, len(configs))
+	visiting := make(map[string]bool, len(configs))
+
+	var length func(path string) time.Duration
+
+	length = func(path string) time.Duration {
+		if l, ok := lengths[path]; ok {
+			return l
+		}
+
+		// Cycles are reported when the queue is built, so they only need to not recurse forever here.
+		if visiting[path] {
+			return 0
+		}
+
+		visiting[path] = true
+
+		var longest time.Duration
+
+		for _, next := range waiting[path] {
+			longest = max(longest, length(next))
+		}
+
+		lengths[path] = weights[path] + longest
+
+		return lengths[path]
+	}
+
+	for _, cfg := range configs {
+		length(cfg.Path)
+	}
+
+	slices.SortStableFunc(configs, func(a, b *discovery.DiscoveredConfig) int {
+		return cmp.Compare(lengths[b.Path], lengths[a.Path])
+	})
+
+	return ranksOf(configs)
+}
+
+// ranksOf returns the index of each of the given configurations, keyed by path.
+func ranksOf(configs discovery.DiscoveredConfigs) map[string]int {
+	ranks := make(map[string]int, len(configs))
+	for i, cfg := range configs {
+		ranks[cfg.Path] = i
+	}
+
+	return ranks
+}
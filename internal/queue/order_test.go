@@ -0,0 +1,118 @@
+package queue_test
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrderTestConfigs returns a chain of three units, c depending on b depending on a, and two independent units
+// that sort before them.
+func newOrderTestConfigs() discovery.DiscoveredConfigs {
+	a := &discovery.DiscoveredConfig{Path: "chain-a"}
+	b := &discovery.DiscoveredConfig{Path: "chain-b", Dependencies: discovery.DiscoveredConfigs{a}}
+	c := &discovery.DiscoveredConfig{Path: "chain-c", Dependencies: discovery.DiscoveredConfigs{b}}
+
+	return discovery.DiscoveredConfigs{
+		c, b, a,
+		{Path: "alone-a"},
+		{Path: "alone-b"},
+	}
+}
+
+func TestNewOrder(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range append([]string{""}, queue.OrderNames...) {
+		order, err := queue.NewOrder(name, nil)
+		require.NoError(t, err, name)
+		assert.NotNil(t, order, name)
+	}
+
+	_, err := queue.NewOrder("fastest", nil)
+	require.ErrorContains(t, err, "invalid queue order")
+}
+
+func TestAlphabeticalOrderRanks(t *testing.T) {
+	t.Parallel()
+
+	ranks := queue.AlphabeticalOrder{}.Ranks(newOrderTestConfigs())
+
+	assert.Equal(t, map[string]int{
+		"alone-a": 0,
+		"alone-b": 1,
+		"chain-a": 2,
+		"chain-b": 3,
+		"chain-c": 4,
+	}, ranks)
+}
+
+func TestRandomOrderRanks(t *testing.T) {
+	t.Parallel()
+
+	configs := newOrderTestConfigs()
+
+	first := queue.RandomOrder{Rand: rand.New(rand.NewPCG(1, 2))}.Ranks(configs)
+	second := queue.RandomOrder{Rand: rand.New(rand.NewPCG(1, 2))}.Ranks(configs)
+
+	assert.Equal(t, first, second)
+	assert.Len(t, first, len(configs))
+}
+
+func TestCriticalPathOrderRanks(t *testing.T) {
+	t.Parallel()
+
+	// The start of the chain has the longest chain of dependents.
+	ranks := queue.CriticalPathOrder{}.Ranks(newOrderTestConfigs())
+
+	assert.Equal(t, 0, ranks["chain-a"])
+	assert.Equal(t, 1, ranks["chain-b"])
+	assert.Less(t, ranks["alone-a"], ranks["alone-b"])
+
+	// Durations weight the chains.
+	ranks = queue.CriticalPathOrder{Durations: map[string]time.Duration{
+		"alone-a": time.Second,
+		"alone-b": time.Hour,
+		"chain-a": time.Minute,
+		"chain-b": time.Minute,
+		"chain-c": time.Minute,
+	}}.Ranks(newOrderTestConfigs())
+
+	assert.Equal(t, 0, ranks["alone-b"])
+	assert.Equal(t, 1, ranks["chain-a"])
+}
+
+func TestCriticalPathOrderRanksDestroy(t *testing.T) {
+	t.Parallel()
+
+	configs := newOrderTestConfigs()
+	for _, cfg := range configs {
+		cfg.DiscoveryContext = &discovery.DiscoveryContext{Cmd: "destroy"}
+	}
+
+	// Units are destroyed after their dependents, so the end of the chain goes first.
+	ranks := queue.CriticalPathOrder{}.Ranks(configs)
+
+	assert.Equal(t, 0, ranks["chain-c"])
+	assert.Equal(t, 1, ranks["chain-b"])
+}
+
+func TestNewQueueWithOrder(t *testing.T) {
+	t.Parallel()
+
+	q, err := queue.NewQueueWithOrder(newOrderTestConfigs(), queue.CriticalPathOrder{})
+	require.NoError(t, err)
+
+	// The chain starts before the independent units of the same level.
+	assert.Equal(t, []string{"chain-a", "alone-a", "alone-b", "chain-b", "chain-c"}, q.Configs().Paths())
+
+	q, err = queue.NewQueue(newOrderTestConfigs())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"alone-a", "alone-b", "chain-a", "chain-b", "chain-c"}, q.Configs().Paths())
+}
@@ -4,7 +4,7 @@
 //
 // The algorithm for populating the queue is as follows:
 //  1. Given a list of discovered configurations, start with an empty queue.
-//  2. Sort configurations alphabetically to ensure deterministic ordering of independent items.
+//  2. Sort configurations alphabetically, or using the Order given to NewQueueWithOrder, to order independent items.
 //  3. For each discovered configuration:
 //     a. If the configuration has no dependencies, append it to the queue.
 //     b. Otherwise, find the position after its last dependency.
@@ -170,6 +170,12 @@ func (q *Queue) Configs() discovery.DiscoveredConfigs {
 // If any cycles are present, the queue construction will halt after N
 // iterations, where N is the number of discovered configs, and throw an error.
 func NewQueue(discovered discovery.DiscoveredConfigs) (*Queue, error) {
+	return NewQueueWithOrder(discovered, AlphabeticalOrder{})
+}
+
+// NewQueueWithOrder creates a new queue from a list of discovered configurations, like NewQueue,
+// but sorts configurations of the same "level" using the given order instead of alphabetically.
+func NewQueueWithOrder(discovered discovery.DiscoveredConfigs, order Order) (*Queue, error) {
 	if len(discovered) == 0 {
 		return &Queue{
 			Entries: Entries{},
@@ -191,6 +197,8 @@ func NewQueue(discovered discovery.DiscoveredConfigs) (*Queue, error) {
 		Entries: entries,
 	}
 
+	ranks := order.Ranks(discovered)
+
 	// readyPending returns the index of the first pending entry if there is one,
 	// or -1 if there are no pending entries.
 	readyPending := func(entries Entries) int {
@@ -207,7 +215,7 @@ func NewQueue(discovered discovery.DiscoveredConfigs) (*Queue, error) {
 			}
 
 			if entries[i].Status == StatusUnsorted && entries[j].Status == StatusUnsorted {
-				return entries[i].Config.Path < entries[j].Config.Path
+				return ranks[entries[i].Config.Path] < ranks[entries[j].Config.Path]
 			}
 
 			return false
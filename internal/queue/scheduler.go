@@ -0,0 +1,183 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Scheduler limits how many units run at once. Each running unit takes its weight from the overall capacity,
+// which is the `--parallelism` of the run, and from the limit of its concurrency group, if it belongs to one.
+// Weights greater than a limit are capped to that limit, so heavy units can still run, one at a time.
+//
+// When capacity frees up, the waiting units are started in order of their rank, lowest first, skipping the units
+// that still don't fit.
+type Scheduler struct {
+	groupLimits map[string]int
+	groupUsed   map[string]int
+	waiters     []*waiter
+	capacity    int
+	used        int
+	mu          sync.Mutex
+}
+
+// waiter is a unit waiting in Acquire.
+type waiter struct {
+	groupFullSince time.Time
+	granted        chan struct{}
+	group          string
+	groupWait      time.Duration
+	weight         int
+	groupWeight    int
+	rank           int
+}
+
+// NewScheduler returns a scheduler with the given overall capacity and concurrency group limits.
+// Groups without a limit, or with a limit lower than 1, are only bound by the overall capacity.
+func NewScheduler(capacity int, groupLimits map[string]int) *Scheduler {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	limits := make(map[string]int, len(groupLimits))
+
+	for group, limit := range groupLimits {
+		if limit > 0 {
+			limits[group] = limit
+		}
+	}
+
+	return &Scheduler{
+		capacity:    capacity,
+		groupLimits: limits,
+		groupUsed:   make(map[string]int),
+	}
+}
+
+// Acquire blocks until a unit of the given group and weight can run, or until the context is done. Waiting units
+// with a lower rank are started first. It returns how long the unit waited because its concurrency group was at its
+// limit, excluding the time it waited for the overall capacity. Each successful Acquire must be followed by a Release
+// with the same group and weight.
+func (s *Scheduler) Acquire(ctx context.Context, group string, weight, rank int) (time.Duration, error) {
+	s.mu.Lock()
+
+	w := &waiter{
+		group:   group,
+		rank:    rank,
+		granted: make(chan struct{}),
+	}
+	w.weight, w.groupWeight = s.weights(group, weight)
+
+	// Keep the waiters sorted by rank, in arrival order for the same rank.
+	i := len(s.waiters)
+	for i > 0 && s.waiters[i-1].rank > rank {
+		i--
+	}
+
+	s.waiters = append(s.waiters, nil)
+	copy(s.waiters[i+1:], s.waiters[i:])
+	s.waiters[i] = w
+
+	s.dispatch()
+	s.mu.Unlock()
+
+	select {
+	case <-w.granted:
+		return w.groupWait, nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-w.granted:
+		// The unit was started while the context was done, so give its capacity back.
+		s.release(w.group, w.weight, w.groupWeight)
+	default:
+		if !w.groupFullSince.IsZero() {
+			w.groupWait += time.Since(w.groupFullSince)
+		}
+
+		for i, waiting := range s.waiters {
+			if waiting == w {
+				s.waiters = append(s.waiters[:i], s.waiters[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return w.groupWait, ctx.Err()
+}
+
+// Release gives back the capacity taken by a unit of the given group and weight, and starts the waiting units that
+// now fit.
+func (s *Scheduler) Release(group string, weight int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	weight, groupWeight := s.weights(group, weight)
+
+	s.release(group, weight, groupWeight)
+}
+
+// release gives back the given weights and starts the waiting units that now fit. It must be called with the lock
+// held.
+func (s *Scheduler) release(group string, weight, groupWeight int) {
+	s.used -= weight
+
+	if groupWeight > 0 {
+		s.groupUsed[group] -= groupWeight
+	}
+
+	s.dispatch()
+}
+
+// dispatch starts the waiting units that fit, in order of their rank, and keeps track of how long the others wait
+// because their concurrency group is at its limit. It must be called with the lock held.
+func (s *Scheduler) dispatch() {
+	now := time.Now()
+	waiters := s.waiters[:0]
+
+	for _, w := range s.waiters {
+		groupFull := w.groupWeight > 0 && s.groupUsed[w.group]+w.groupWeight > s.groupLimits[w.group]
+
+		switch {
+		case groupFull && w.groupFullSince.IsZero():
+			w.groupFullSince = now
+		case !groupFull && !w.groupFullSince.IsZero():
+			w.groupWait += now.Sub(w.groupFullSince)
+			w.groupFullSince = time.Time{}
+		}
+
+		if groupFull || s.used+w.weight > s.capacity {
+			waiters = append(waiters, w)
+			continue
+		}
+
+		s.used += w.weight
+
+		if w.groupWeight > 0 {
+			s.groupUsed[w.group] += w.groupWeight
+		}
+
+		close(w.granted)
+	}
+
+	clear(s.waiters[len(waiters):])
+	s.waiters = waiters
+}
+
+// weights returns the weight the unit takes from the overall capacity and from the limit of its group, capped to
+// those limits. The group weight is 0 if the group has no limit.
+func (s *Scheduler) weights(group string, weight int) (int, int) {
+	weight = max(weight, 1)
+
+	var groupWeight int
+
+	if limit, ok := s.groupLimits[group]; ok {
+		groupWeight = min(weight, limit)
+	}
+
+	return min(weight, s.capacity), groupWeight
+}
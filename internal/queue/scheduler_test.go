@@ -0,0 +1,164 @@
+package queue_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerGroupLimit(t *testing.T) {
+	t.Parallel()
+
+	scheduler := queue.NewScheduler(10, map[string]int{"db": 2})
+
+	var (
+		wg              sync.WaitGroup
+		running, maxRun atomic.Int32
+	)
+
+	for range 6 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := scheduler.Acquire(t.Context(), "db", 1, 0)
+			assert.NoError(t, err)
+
+			defer scheduler.Release("db", 1)
+
+			current := running.Add(1)
+			for {
+				highest := maxRun.Load()
+				if current <= highest || maxRun.CompareAndSwap(highest, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxRun.Load())
+}
+
+func TestSchedulerGroupWait(t *testing.T) {
+	t.Parallel()
+
+	scheduler := queue.NewScheduler(10, map[string]int{"db": 1})
+
+	wait, err := scheduler.Acquire(t.Context(), "db", 1, 0)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// Units of other groups, or without a group, are not blocked by the limit of the group.
+	wait, err = scheduler.Acquire(t.Context(), "", 1, 0)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	scheduler.Release("", 1)
+
+	done := make(chan time.Duration)
+
+	go func() {
+		wait, err := scheduler.Acquire(t.Context(), "db", 1, 0)
+		assert.NoError(t, err)
+
+		scheduler.Release("db", 1)
+
+		done <- wait
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	scheduler.Release("db", 1)
+
+	assert.GreaterOrEqual(t, <-done, 50*time.Millisecond)
+}
+
+func TestSchedulerWeights(t *testing.T) {
+	t.Parallel()
+
+	scheduler := queue.NewScheduler(4, nil)
+
+	// A weight greater than the capacity is capped, so the unit can still run.
+	wait, err := scheduler.Acquire(t.Context(), "", 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = scheduler.Acquire(ctx, "", 1, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	scheduler.Release("", 10)
+
+	_, err = scheduler.Acquire(t.Context(), "", 3, 0)
+	require.NoError(t, err)
+
+	_, err = scheduler.Acquire(t.Context(), "", 1, 0)
+	require.NoError(t, err)
+}
+
+func TestSchedulerCanceled(t *testing.T) {
+	t.Parallel()
+
+	scheduler := queue.NewScheduler(1, map[string]int{"db": 1})
+
+	_, err := scheduler.Acquire(t.Context(), "db", 1, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = scheduler.Acquire(ctx, "db", 1, 0)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSchedulerRanks(t *testing.T) {
+	t.Parallel()
+
+	scheduler := queue.NewScheduler(1, nil)
+
+	_, err := scheduler.Acquire(t.Context(), "", 1, 0)
+	require.NoError(t, err)
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		order []int
+	)
+
+	for _, rank := range []int{3, 1, 2} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := scheduler.Acquire(t.Context(), "", 1, rank)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			order = append(order, rank)
+			mu.Unlock()
+
+			scheduler.Release("", 1)
+		}()
+	}
+
+	// Let all the units wait, then start them one at a time.
+	time.Sleep(50 * time.Millisecond)
+	scheduler.Release("", 1)
+
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3}, order)
+}
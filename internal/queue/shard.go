@@ -0,0 +1,323 @@
+package queue
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+)
+
+// defaultUnitDuration is the duration of the units when no duration is known for any unit, so the shards are
+// balanced by their number of units.
+const defaultUnitDuration = time.Second
+
+// Shard identifies one of the shards a run queue is split into. The index is 1-based.
+type Shard struct {
+	Index int
+	Count int
+}
+
+// ParseShard parses a shard given as `<index>/<count>`, e.g. `2/5`.
+func ParseShard(value string) (*Shard, error) {
+	indexStr, countStr, ok := strings.Cut(value, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid shard %q, expected <index>/<count>", value)
+	}
+
+	index, err := strconv.Atoi(strings.TrimSpace(indexStr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard index in %q: %w", value, err)
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(countStr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard count in %q: %w", value, err)
+	}
+
+	if count < 1 || index < 1 || index > count {
+		return nil, fmt.Errorf("invalid shard %q, the index must be between 1 and the count", value)
+	}
+
+	return &Shard{Index: index, Count: count}, nil
+}
+
+func (shard *Shard) String() string {
+	return fmt.Sprintf("%d/%d", shard.Index, shard.Count)
+}
+
+// CrossShardDependency is a dependency between units assigned to different shards.
+type CrossShardDependency struct {
+	Dependent       *discovery.DiscoveredConfig
+	Dependency      *discovery.DiscoveredConfig
+	DependentShard  int
+	DependencyShard int
+}
+
+// Shards is a run queue split into shards.
+type Shards struct {
+	assignments map[string]int
+
+	// Queues are the run queues of the shards, the first one being the queue of shard 1.
+	Queues []*Queue
+
+	// CrossShardDependencies are the dependencies between units assigned to different shards.
+	CrossShardDependencies []CrossShardDependency
+}
+
+// NewShards splits the given discovered configurations into the given number of shards.
+//
+// Units that depend on each other, directly or not, stay in the same shard, unless they take longer than a shard
+// should, in which case they are split in dependency order, keeping the dependency chain of each unit together
+// where possible. The resulting dependencies between shards are listed in CrossShardDependencies.
+//
+// Shards are balanced using the given durations of the units, keyed by path. Units without a duration are assumed
+// to take the average duration of the others, and shards are balanced by number of units if no duration is given.
+//
+// The split only depends on the paths, dependencies and durations of the units, so every shard of a run can compute
+// it independently.
+func NewShards(discovered discovery.DiscoveredConfigs, count int, durations map[string]time.Duration) (*Shards, error) {
+	if count < 1 {
+		return nil, errors.New("the number of shards must be at least 1")
+	}
+
+	configs := slices.Clone(discovered).Sort()
+
+	byPath := make(map[string]*discovery.DiscoveredConfig, len(configs))
+	for _, cfg := range configs {
+		byPath[cfg.Path] = cfg
+	}
+
+	weights := unitWeights(configs, durations)
+
+	var total, heaviest time.Duration
+
+	for _, weight := range weights {
+		total += weight
+		heaviest = max(heaviest, weight)
+	}
+
+	pieceWeight := func(piece discovery.DiscoveredConfigs) time.Duration {
+		var weight time.Duration
+		for _, cfg := range piece {
+			weight += weights[cfg.Path]
+		}
+
+		return weight
+	}
+
+	// Shards can't be balanced closer than the weight of a unit, so only the components heavier than a shard should
+	// be by more than the heaviest unit are split.
+	target := total / time.Duration(count)
+
+	var pieces []discovery.DiscoveredConfigs
+
+	for _, component := range connectedComponents(configs, byPath) {
+		if pieceWeight(component) <= target+heaviest {
+			pieces = append(pieces, component)
+
+			continue
+		}
+
+		pieces = append(pieces, splitComponent(component, weights, target)...)
+	}
+
+	// Assign the heaviest pieces first, each to the least loaded shard.
+	slices.SortStableFunc(pieces, func(a, b discovery.DiscoveredConfigs) int {
+		return cmp.Compare(pieceWeight(b), pieceWeight(a))
+	})
+
+	shards := &Shards{assignments: make(map[string]int, len(configs))}
+	loads := make([]time.Duration, count)
+	shardConfigs := make([]discovery.DiscoveredConfigs, count)
+
+	for _, piece := range pieces {
+		shard := 0
+
+		for i := range loads {
+			if loads[i] < loads[shard] {
+				shard = i
+			}
+		}
+
+		loads[shard] += pieceWeight(piece)
+
+		for _, cfg := range piece {
+			shards.assignments[cfg.Path] = shard + 1
+			shardConfigs[shard] = append(shardConfigs[shard], cfg)
+		}
+	}
+
+	for _, cfgs := range shardConfigs {
+		q, err := NewQueue(cfgs)
+		if err != nil {
+			return nil, err
+		}
+
+		shards.Queues = append(shards.Queues, q)
+	}
+
+	for _, cfg := range configs {
+		for _, dep := range cfg.Dependencies {
+			if _, ok := byPath[dep.Path]; !ok {
+				continue
+			}
+
+			if shards.assignments[cfg.Path] != shards.assignments[dep.Path] {
+				shards.CrossShardDependencies = append(shards.CrossShardDependencies, CrossShardDependency{
+					Dependent:       cfg,
+					Dependency:      byPath[dep.Path],
+					DependentShard:  shards.assignments[cfg.Path],
+					DependencyShard: shards.assignments[dep.Path],
+				})
+			}
+		}
+	}
+
+	return shards, nil
+}
+
+// Shard returns the 1-based index of the shard the unit with the given path is assigned to, or 0 if the unit was not
+// part of the split.
+func (shards *Shards) Shard(path string) int {
+	return shards.assignments[path]
+}
+
+// unitWeights returns the duration of each unit used to balance the shards.
+func unitWeights(configs discovery.DiscoveredConfigs, durations map[string]time.Duration) map[string]time.Duration {
+	var (
+		known int
+		sum   time.Duration
+	)
+
+	for _, cfg := range configs {
+		if duration, ok := durations[cfg.Path]; ok && duration > 0 {
+			known++
+			sum += duration
+		}
+	}
+
+	fallback := defaultUnitDuration
+	if known > 0 {
+		fallback = sum / time.Duration(known)
+	}
+
+	weights := make(map[string]time.Duration, len(configs))
+
+	for _, cfg := range configs {
+		weight, ok := durations[cfg.Path]
+		if !ok || weight <= 0 {
+			weight = fallback
+		}
+
+		weights[cfg.Path] = weight
+	}
+
+	return weights
+}
+
+// connectedComponents returns the groups of units that depend on each other, directly or not. Each group is in
+// dependency order, with the dependency chain of each unit right before it where possible.
+func connectedComponents(configs discovery.DiscoveredConfigs, byPath map[string]*discovery.DiscoveredConfig) []discovery.DiscoveredConfigs {
+	parents := make(map[string]string, len(configs))
+
+	var find func(path string) string
+
+	find = func(path string) string {
+		if parents[path] == path {
+			return path
+		}
+
+		parents[path] = find(parents[path])
+
+		return parents[path]
+	}
+
+	for _, cfg := range configs {
+		parents[cfg.Path] = cfg.Path
+	}
+
+	for _, cfg := range configs {
+		for _, dep := range cfg.Dependencies {
+			if _, ok := byPath[dep.Path]; !ok {
+				continue
+			}
+
+			if root, depRoot := find(cfg.Path), find(dep.Path); root != depRoot {
+				parents[depRoot] = root
+			}
+		}
+	}
+
+	var (
+		components []discovery.DiscoveredConfigs
+		indexes    = make(map[string]int)
+		visited    = make(map[string]bool, len(configs))
+	)
+
+	var visit func(cfg *discovery.DiscoveredConfig)
+
+	visit = func(cfg *discovery.DiscoveredConfig) {
+		if visited[cfg.Path] {
+			return
+		}
+
+		visited[cfg.Path] = true
+
+		deps := make(discovery.DiscoveredConfigs, 0, len(cfg.Dependencies))
+
+		for _, dep := range cfg.Dependencies {
+			if dep, ok := byPath[dep.Path]; ok {
+				deps = append(deps, dep)
+			}
+		}
+
+		for _, dep := range deps.Sort() {
+			visit(dep)
+		}
+
+		root := find(cfg.Path)
+
+		index, ok := indexes[root]
+		if !ok {
+			index = len(components)
+			indexes[root] = index
+
+			components = append(components, nil)
+		}
+
+		components[index] = append(components[index], cfg)
+	}
+
+	for _, cfg := range configs {
+		visit(cfg)
+	}
+
+	return components
+}
+
+// splitComponent splits a component into pieces that take at most the target duration, or are a single unit,
+// following the dependency order of the component.
+func splitComponent(component discovery.DiscoveredConfigs, weights map[string]time.Duration, target time.Duration) []discovery.DiscoveredConfigs {
+	var (
+		pieces []discovery.DiscoveredConfigs
+		piece  discovery.DiscoveredConfigs
+		weight time.Duration
+	)
+
+	for _, cfg := range component {
+		if len(piece) > 0 && weight+weights[cfg.Path] > target {
+			pieces = append(pieces, piece)
+			piece, weight = nil, 0
+		}
+
+		piece = append(piece, cfg)
+		weight += weights[cfg.Path]
+	}
+
+	return append(pieces, piece)
+}
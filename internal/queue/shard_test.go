@@ -0,0 +1,142 @@
+package queue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		expected *queue.Shard
+		wantErr  bool
+	}{
+		{value: "1/1", expected: &queue.Shard{Index: 1, Count: 1}},
+		{value: "2/5", expected: &queue.Shard{Index: 2, Count: 5}},
+		{value: "0/5", wantErr: true},
+		{value: "6/5", wantErr: true},
+		{value: "1/0", wantErr: true},
+		{value: "a/5", wantErr: true},
+		{value: "2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			shard, err := queue.ParseShard(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, shard)
+			assert.Equal(t, tt.value, shard.String())
+		})
+	}
+}
+
+func TestNewShardsKeepsDependenciesTogether(t *testing.T) {
+	t.Parallel()
+
+	// Two independent chains, and a unit without dependencies.
+	vpc := &discovery.DiscoveredConfig{Path: "vpc"}
+	app := &discovery.DiscoveredConfig{Path: "app", Dependencies: discovery.DiscoveredConfigs{vpc}}
+	db := &discovery.DiscoveredConfig{Path: "db"}
+	api := &discovery.DiscoveredConfig{Path: "api", Dependencies: discovery.DiscoveredConfigs{db}}
+	dns := &discovery.DiscoveredConfig{Path: "dns"}
+
+	shards, err := queue.NewShards(discovery.DiscoveredConfigs{vpc, app, db, api, dns}, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, shards.Queues, 3)
+
+	assert.Equal(t, shards.Shard("vpc"), shards.Shard("app"))
+	assert.Equal(t, shards.Shard("db"), shards.Shard("api"))
+	assert.NotEqual(t, shards.Shard("vpc"), shards.Shard("db"))
+	assert.NotEqual(t, shards.Shard("dns"), shards.Shard("vpc"))
+	assert.NotEqual(t, shards.Shard("dns"), shards.Shard("db"))
+	assert.Empty(t, shards.CrossShardDependencies)
+
+	// The queue of each shard is in dependency order.
+	assert.Equal(t, []string{"vpc", "app"}, shards.Queues[shards.Shard("vpc")-1].Configs().Paths())
+}
+
+func TestNewShardsBalancesDurations(t *testing.T) {
+	t.Parallel()
+
+	configs := discovery.DiscoveredConfigs{
+		{Path: "a"},
+		{Path: "b"},
+		{Path: "c"},
+		{Path: "d"},
+	}
+
+	durations := map[string]time.Duration{
+		"a": 10 * time.Minute,
+		"b": 4 * time.Minute,
+		"c": 3 * time.Minute,
+		"d": 3 * time.Minute,
+	}
+
+	shards, err := queue.NewShards(configs, 2, durations)
+	require.NoError(t, err)
+
+	// The slow unit gets a shard of its own.
+	assert.Equal(t, []string{"a"}, shards.Queues[shards.Shard("a")-1].Configs().Paths())
+	assert.Len(t, shards.Queues[shards.Shard("b")-1].Configs(), 3)
+}
+
+func TestNewShardsSplitsLargeComponents(t *testing.T) {
+	t.Parallel()
+
+	// Every unit depends on the root unit, so they all form a single component.
+	root := &discovery.DiscoveredConfig{Path: "root"}
+	configs := discovery.DiscoveredConfigs{root}
+
+	for _, path := range []string{"a", "b", "c", "d", "e"} {
+		configs = append(configs, &discovery.DiscoveredConfig{Path: path, Dependencies: discovery.DiscoveredConfigs{root}})
+	}
+
+	shards, err := queue.NewShards(configs, 2, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, shards.Queues[0].Configs(), 3)
+	assert.Len(t, shards.Queues[1].Configs(), 3)
+
+	// The units split from the root unit are reported.
+	require.NotEmpty(t, shards.CrossShardDependencies)
+
+	for _, dep := range shards.CrossShardDependencies {
+		assert.Equal(t, "root", dep.Dependency.Path)
+		assert.Equal(t, shards.Shard("root"), dep.DependencyShard)
+		assert.NotEqual(t, dep.DependencyShard, dep.DependentShard)
+	}
+}
+
+func TestNewShardsIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	a := &discovery.DiscoveredConfig{Path: "a"}
+	b := &discovery.DiscoveredConfig{Path: "b", Dependencies: discovery.DiscoveredConfigs{a}}
+	c := &discovery.DiscoveredConfig{Path: "c"}
+	d := &discovery.DiscoveredConfig{Path: "d"}
+
+	first, err := queue.NewShards(discovery.DiscoveredConfigs{a, b, c, d}, 2, nil)
+	require.NoError(t, err)
+
+	second, err := queue.NewShards(discovery.DiscoveredConfigs{d, c, b, a}, 2, nil)
+	require.NoError(t, err)
+
+	for _, path := range []string{"a", "b", "c", "d"} {
+		assert.Equal(t, first.Shard(path), second.Shard(path), "unit %s", path)
+	}
+}
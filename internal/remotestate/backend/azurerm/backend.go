@@ -0,0 +1,230 @@
+// Package azurerm represents Azure Blob Storage backend for interacting with remote state.
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const BackendName = "azurerm"
+
+var _ backend.Backend = new(Backend)
+
+type Backend struct {
+	*backend.CommonBackend
+}
+
+func NewBackend() *Backend {
+	return &Backend{
+		CommonBackend: backend.NewCommonBackend(BackendName),
+	}
+}
+
+// NeedsBootstrap returns true if the storage account or the container specified in the given config does not exist.
+//
+// Returns true if:
+//
+// 1. The configured storage account does not exist
+// 2. The configured container does not exist
+func (backend *Backend) NeedsBootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (bool, error) {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return false, err
+	}
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return false, err
+	}
+
+	if exists, err := client.DoesStorageAccountExist(ctx, l); err != nil || !exists {
+		return true, err
+	}
+
+	if exists, err := client.DoesContainerExist(ctx, extAzureRMCfg.RemoteStateConfigAzureRM.ContainerName); err != nil || !exists {
+		return true, err
+	}
+
+	return false, nil
+}
+
+// Bootstrap the remote state storage account and container specified in the given config. This function will validate
+// the config parameters, create the storage account and the container if they don't already exist, and check that blob
+// versioning is enabled.
+func (backend *Backend) Bootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		azCfg         = &extAzureRMCfg.RemoteStateConfigAzureRM
+		accountName   = azCfg.StorageAccountName
+		containerName = azCfg.ContainerName
+	)
+
+	// ensure that only one goroutine can initialize storage account
+	mu := backend.GetBucketMutex(accountName)
+	mu.Lock()
+	defer mu.Unlock()
+
+	if backend.IsConfigInited(azCfg) {
+		l.Debugf("%s container %s has already been confirmed to be initialized, skipping initialization checks", backend.Name(), azCfg.CacheKey())
+
+		return nil
+	}
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return err
+	}
+
+	if !extAzureRMCfg.SkipStorageAccountCreation {
+		if err := client.CreateStorageAccountIfNecessary(ctx, l, opts); err != nil {
+			return err
+		}
+	}
+
+	if !extAzureRMCfg.SkipContainerCreation {
+		if err := client.CreateContainerIfNecessary(ctx, l, containerName); err != nil {
+			return err
+		}
+	}
+
+	if !extAzureRMCfg.SkipBlobVersioning {
+		if _, err := client.CheckIfVersioningEnabled(ctx, l); err != nil {
+			return err
+		}
+	}
+
+	backend.MarkConfigInited(azCfg)
+
+	return nil
+}
+
+// IsVersionControlEnabled returns true if blob versioning for the storage account is enabled.
+func (backend *Backend) IsVersionControlEnabled(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (bool, error) {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return false, err
+	}
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return false, err
+	}
+
+	return client.CheckIfVersioningEnabled(ctx, l)
+}
+
+// Migrate moves the blob located at src config to dst config. The src and dst configs may point to different storage accounts.
+func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *options.TerragruntOptions) error {
+	srcExtAzureRMCfg, err := Config(srcBackendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return err
+	}
+
+	dstExtAzureRMCfg, err := Config(dstBackendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		srcContainerName = srcExtAzureRMCfg.RemoteStateConfigAzureRM.ContainerName
+		srcKey           = srcExtAzureRMCfg.RemoteStateConfigAzureRM.Key
+
+		dstContainerName = dstExtAzureRMCfg.RemoteStateConfigAzureRM.ContainerName
+		dstKey           = dstExtAzureRMCfg.RemoteStateConfigAzureRM.Key
+	)
+
+	srcClient, err := NewClient(srcExtAzureRMCfg)
+	if err != nil {
+		return err
+	}
+
+	dstClient, err := NewClient(dstExtAzureRMCfg)
+	if err != nil {
+		return err
+	}
+
+	return srcClient.MoveBlobIfNecessary(ctx, l, dstClient, srcContainerName, srcKey, dstContainerName, dstKey)
+}
+
+// Delete deletes the remote state specified in the given config.
+func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		containerName = extAzureRMCfg.RemoteStateConfigAzureRM.ContainerName
+		key           = extAzureRMCfg.RemoteStateConfigAzureRM.Key
+	)
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("Azure storage container %s blob %s will be deleted. Do you want to continue?", containerName, key)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil {
+		return err
+	} else if yes {
+		return client.DeleteBlobIfNecessary(ctx, l, containerName, key)
+	}
+
+	return nil
+}
+
+// DeleteBucket deletes the entire container specified in the given config. The storage account itself is left intact,
+// since it may hold other containers.
+func (backend *Backend) DeleteBucket(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return err
+	}
+
+	var containerName = extAzureRMCfg.RemoteStateConfigAzureRM.ContainerName
+
+	prompt := fmt.Sprintf("Azure storage container %s will be completely deleted. Do you want to continue?", containerName)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil {
+		return err
+	} else if yes {
+		return client.DeleteContainerIfNecessary(ctx, l, containerName)
+	}
+
+	return nil
+}
+
+// Verify compares the storage account and container specified in the given config with their actual settings
+// and returns every mismatch.
+func (backend *Backend) Verify(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (backend.Drifts, error) {
+	extAzureRMCfg, err := Config(backendConfig).ExtendedAzureRMConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(extAzureRMCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.VerifyStorage(ctx, l, extAzureRMCfg.RemoteStateConfigAzureRM.ContainerName)
+}
+
+// GetTFInitArgs returns the subset of the given config that should be passed to terraform init
+// when initializing the remote state.
+func (backend *Backend) GetTFInitArgs(config backend.Config) map[string]any {
+	return Config(config).FilterOutTerragruntKeys()
+}
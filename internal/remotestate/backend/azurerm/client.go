@@ -0,0 +1,537 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+	"github.com/gruntwork-io/terragrunt/util"
+)
+
+const (
+	azureMaxRetries          = 3
+	azureSleepBetweenRetries = 10 * time.Second
+
+	// blobVersioningPropertiesName is the only allowed name of the blob service properties of a storage account.
+	blobVersioningPropertiesName = "default"
+)
+
+// cloudConfigurations maps the `environment` values supported by the azurerm backend to the Azure cloud configuration.
+var cloudConfigurations = map[string]cloud.Configuration{
+	"public":       cloud.AzurePublic,
+	"china":        cloud.AzureChina,
+	"usgovernment": cloud.AzureGovernment,
+}
+
+type Client struct {
+	*ExtendedRemoteStateConfigAzureRM
+	*azblob.Client
+
+	tokenCredential azcore.TokenCredential
+}
+
+// NewClient inits AzureRM client. The data plane client is authenticated with the SAS token or the access key
+// if any of them are given, otherwise with Azure AD credentials.
+func NewClient(config *ExtendedRemoteStateConfigAzureRM) (*Client, error) {
+	var (
+		azCfg       = config.RemoteStateConfigAzureRM
+		endpoint    = config.GetBlobEndpoint()
+		clientOpts  = &azblob.ClientOptions{ClientOptions: config.clientOptions()}
+		blobClient  *azblob.Client
+		accessKey   = azCfg.AccessKey
+		client      = &Client{ExtendedRemoteStateConfigAzureRM: config}
+		err         error
+		useADClient = azCfg.UseAzureADAuth || azCfg.UseMSI || azCfg.UseOIDC
+	)
+
+	if accessKey == "" {
+		accessKey = os.Getenv("ARM_ACCESS_KEY")
+	}
+
+	switch {
+	case !useADClient && azCfg.SASToken != "":
+		blobClient, err = azblob.NewClientWithNoCredential(endpoint+"?"+azCfg.SASToken, clientOpts)
+	case !useADClient && accessKey != "":
+		cred, credErr := azblob.NewSharedKeyCredential(azCfg.StorageAccountName, accessKey)
+		if credErr != nil {
+			return nil, errors.Errorf("error creating Azure shared key credential: %w", credErr)
+		}
+
+		blobClient, err = azblob.NewClientWithSharedKeyCredential(endpoint, cred, clientOpts)
+	default:
+		cred, credErr := client.getTokenCredential()
+		if credErr != nil {
+			return nil, credErr
+		}
+
+		blobClient, err = azblob.NewClient(endpoint, cred, clientOpts)
+	}
+
+	if err != nil {
+		return nil, errors.Errorf("error creating Azure blob client: %w", err)
+	}
+
+	client.Client = blobClient
+
+	return client, nil
+}
+
+// CreateStorageAccountIfNecessary prompts the user to create the storage account if it doesn't already exist and if the user
+// confirms, creates the storage account and enables blob versioning for it.
+func (client *Client) CreateStorageAccountIfNecessary(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	accountName := client.RemoteStateConfigAzureRM.StorageAccountName
+
+	if exists, err := client.DoesStorageAccountExist(ctx, l); err != nil || exists {
+		return err
+	}
+
+	// A resource group and subscription must be specified in order for terragrunt to automatically create a storage account.
+	if client.RemoteStateConfigAzureRM.ResourceGroupName == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("resource_group_name"))
+	}
+
+	if client.RemoteStateConfigAzureRM.SubscriptionID == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("subscription_id"))
+	}
+
+	// A location must be specified in order for terragrunt to automatically create a storage account.
+	if client.Location == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("location"))
+	}
+
+	l.Debugf("Remote state Azure storage account %s does not exist. Attempting to create it", accountName)
+
+	if opts.FailIfBucketCreationRequired {
+		return backend.BucketCreationNotAllowed(accountName)
+	}
+
+	prompt := fmt.Sprintf("Remote state Azure storage account %s does not exist or you don't have permissions to access it. Would you like Terragrunt to create it?", accountName)
+
+	shouldCreateAccount, err := shell.PromptUserForYesNo(ctx, l, prompt, opts)
+	if err != nil || !shouldCreateAccount {
+		return err
+	}
+
+	return client.CreateStorageAccountWithVersioning(ctx, l)
+}
+
+// CreateStorageAccountWithVersioning creates the storage account and enables blob versioning for it.
+func (client *Client) CreateStorageAccountWithVersioning(ctx context.Context, l log.Logger) error {
+	if err := client.CreateStorageAccount(ctx, l); err != nil {
+		return err
+	}
+
+	if client.SkipBlobVersioning {
+		l.Debugf("Versioning is disabled for the remote state Azure storage account %s using 'skip_blob_versioning' config.", client.RemoteStateConfigAzureRM.StorageAccountName)
+
+		return nil
+	}
+
+	return client.EnableVersioningForStorageAccount(ctx, l)
+}
+
+// CreateStorageAccount creates the storage account specified in the given config and waits until it is provisioned.
+func (client *Client) CreateStorageAccount(ctx context.Context, l log.Logger) error {
+	var (
+		azCfg       = client.RemoteStateConfigAzureRM
+		accountName = azCfg.StorageAccountName
+	)
+
+	l.Debugf("Creating Azure storage account %s in resource group %s", accountName, azCfg.ResourceGroupName)
+
+	factory, err := client.newResourceManagerClientFactory()
+	if err != nil {
+		return err
+	}
+
+	tags := make(map[string]*string, len(client.StorageAccountTags))
+	for key, value := range client.StorageAccountTags {
+		tags[key] = to.Ptr(value)
+	}
+
+	params := armstorage.AccountCreateParameters{
+		Kind:     to.Ptr(armstorage.Kind(client.GetAccountKind())),
+		Location: to.Ptr(client.Location),
+		SKU:      &armstorage.SKU{Name: to.Ptr(armstorage.SKUName(client.GetSKUName()))},
+		Tags:     tags,
+		Properties: &armstorage.AccountPropertiesCreateParameters{
+			AllowBlobPublicAccess:  to.Ptr(false),
+			EnableHTTPSTrafficOnly: to.Ptr(true),
+			MinimumTLSVersion:      to.Ptr(armstorage.MinimumTLSVersionTLS12),
+		},
+	}
+
+	poller, err := factory.NewAccountsClient().BeginCreate(ctx, azCfg.ResourceGroupName, accountName, params, nil)
+	if err != nil {
+		return errors.Errorf("error creating Azure storage account %s: %w", accountName, err)
+	}
+
+	if _, err := poller.PollUntilDone(ctx, nil); err != nil {
+		return errors.Errorf("error waiting for Azure storage account %s to be created: %w", accountName, err)
+	}
+
+	l.Debugf("Created Azure storage account %s", accountName)
+
+	return nil
+}
+
+// DoesStorageAccountExist returns true if the storage account specified in the given config exists. If the config does
+// not contain the subscription and resource group of the account, the existence cannot be checked with the Azure
+// Resource Manager API and the account is assumed to exist.
+func (client *Client) DoesStorageAccountExist(ctx context.Context, l log.Logger) (bool, error) {
+	azCfg := client.RemoteStateConfigAzureRM
+
+	if !client.CanManageStorageAccount() {
+		l.Debugf("No subscription_id or resource_group_name specified, assuming Azure storage account %s exists", azCfg.StorageAccountName)
+
+		return true, nil
+	}
+
+	factory, err := client.newResourceManagerClientFactory()
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := factory.NewAccountsClient().GetProperties(ctx, azCfg.ResourceGroupName, azCfg.StorageAccountName, nil); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, errors.Errorf("error getting Azure storage account %s properties: %w", azCfg.StorageAccountName, err)
+	}
+
+	return true, nil
+}
+
+// CheckIfVersioningEnabled checks if blob versioning is enabled for the storage account specified in the given config and warns the user if it is not.
+func (client *Client) CheckIfVersioningEnabled(ctx context.Context, l log.Logger) (bool, error) {
+	azCfg := client.RemoteStateConfigAzureRM
+
+	if !client.CanManageStorageAccount() {
+		l.Warnf("Unable to check blob versioning of the remote state Azure storage account %s, subscription_id and resource_group_name are required.", azCfg.StorageAccountName)
+
+		return false, nil
+	}
+
+	if exists, err := client.DoesStorageAccountExist(ctx, l); err != nil {
+		return false, err
+	} else if !exists {
+		return false, backend.NewBucketDoesNotExistError(azCfg.StorageAccountName)
+	}
+
+	factory, err := client.newResourceManagerClientFactory()
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := factory.NewBlobServicesClient().GetServiceProperties(ctx, azCfg.ResourceGroupName, azCfg.StorageAccountName, nil)
+	if err != nil {
+		return false, errors.Errorf("error getting blob service properties of Azure storage account %s: %w", azCfg.StorageAccountName, err)
+	}
+
+	enabled := resp.BlobServiceProperties.BlobServiceProperties != nil &&
+		resp.BlobServiceProperties.BlobServiceProperties.IsVersioningEnabled != nil &&
+		*resp.BlobServiceProperties.BlobServiceProperties.IsVersioningEnabled
+
+	if !enabled {
+		l.Warnf("Versioning is not enabled for the remote state Azure storage account %s. We recommend enabling versioning so that you can roll back to previous versions of your OpenTofu/Terraform state in case of error.", azCfg.StorageAccountName)
+	}
+
+	return enabled, nil
+}
+
+// VerifyStorage compares the storage account and the given container with the config and returns every mismatch.
+// Storage account settings are only checked if the config contains the subscription and resource group of the account.
+func (client *Client) VerifyStorage(ctx context.Context, l log.Logger, containerName string) (backend.Drifts, error) {
+	var (
+		accountResource   = "Azure storage account " + client.RemoteStateConfigAzureRM.StorageAccountName
+		containerResource = "Azure storage container " + containerName
+		drifts            backend.Drifts
+	)
+
+	if exists, err := client.DoesStorageAccountExist(ctx, l); err != nil {
+		return nil, err
+	} else if !exists {
+		return backend.Drifts{backend.NewMissingResourceDrift(accountResource)}, nil
+	}
+
+	if !client.SkipBlobVersioning && client.CanManageStorageAccount() {
+		enabled, err := client.CheckIfVersioningEnabled(ctx, l)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDisabledSettingDrift(accountResource, "blob versioning"))
+		}
+	}
+
+	if exists, err := client.DoesContainerExist(ctx, containerName); err != nil {
+		return nil, err
+	} else if !exists {
+		drifts = append(drifts, backend.NewMissingResourceDrift(containerResource))
+	}
+
+	return drifts, nil
+}
+
+// EnableVersioningForStorageAccount enables blob versioning for the storage account specified in the given config.
+func (client *Client) EnableVersioningForStorageAccount(ctx context.Context, l log.Logger) error {
+	azCfg := client.RemoteStateConfigAzureRM
+
+	l.Debugf("Enabling blob versioning on Azure storage account %s", azCfg.StorageAccountName)
+
+	factory, err := client.newResourceManagerClientFactory()
+	if err != nil {
+		return err
+	}
+
+	props := armstorage.BlobServiceProperties{
+		Name: to.Ptr(blobVersioningPropertiesName),
+		BlobServiceProperties: &armstorage.BlobServicePropertiesProperties{
+			IsVersioningEnabled: to.Ptr(true),
+		},
+	}
+
+	if _, err := factory.NewBlobServicesClient().SetServiceProperties(ctx, azCfg.ResourceGroupName, azCfg.StorageAccountName, props, nil); err != nil {
+		return errors.Errorf("error enabling blob versioning on Azure storage account %s: %w", azCfg.StorageAccountName, err)
+	}
+
+	return nil
+}
+
+// CreateContainerIfNecessary creates the given container if it doesn't already exist. Since the storage account might
+// have just been created, we use a retry loop to avoid any DNS propagation issues.
+func (client *Client) CreateContainerIfNecessary(ctx context.Context, l log.Logger, containerName string) error {
+	description := "Create Azure storage container " + containerName
+
+	return util.DoWithRetry(ctx, description, azureMaxRetries, azureSleepBetweenRetries, l, log.DebugLevel, func(ctx context.Context) error {
+		if exists, err := client.DoesContainerExist(ctx, containerName); err != nil || exists {
+			return err
+		}
+
+		return client.CreateContainer(ctx, l, containerName)
+	})
+}
+
+// CreateContainer creates the given container in the storage account.
+func (client *Client) CreateContainer(ctx context.Context, l log.Logger, containerName string) error {
+	l.Debugf("Creating Azure storage container %s in storage account %s", containerName, client.RemoteStateConfigAzureRM.StorageAccountName)
+
+	if _, err := client.Client.CreateContainer(ctx, containerName, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+			return nil
+		}
+
+		return errors.Errorf("error creating Azure storage container %s: %w", containerName, err)
+	}
+
+	return nil
+}
+
+// DoesContainerExist returns true if the given container exists and the current user has the ability to access it.
+func (client *Client) DoesContainerExist(ctx context.Context, containerName string) (bool, error) {
+	if _, err := client.containerClient(containerName).GetProperties(ctx, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.ContainerNotFound, bloberror.ContainerBeingDeleted) {
+			return false, nil
+		}
+
+		return false, errors.Errorf("error getting Azure storage container %s properties: %w", containerName, err)
+	}
+
+	return true, nil
+}
+
+// DeleteContainerIfNecessary deletes the given container with all its blobs if it exists.
+func (client *Client) DeleteContainerIfNecessary(ctx context.Context, l log.Logger, containerName string) error {
+	if exists, err := client.DoesContainerExist(ctx, containerName); err != nil || !exists {
+		return err
+	}
+
+	l.Debugf("Deleting Azure storage container %s", containerName)
+
+	if _, err := client.Client.DeleteContainer(ctx, containerName, nil); err != nil {
+		return errors.Errorf("error deleting Azure storage container %s: %w", containerName, err)
+	}
+
+	return nil
+}
+
+// DoesBlobExist returns true if the specified blob exists otherwise false.
+func (client *Client) DoesBlobExist(ctx context.Context, containerName, key string) (bool, error) {
+	if _, err := client.blobClient(containerName, key).GetProperties(ctx, nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return false, nil
+		}
+
+		return false, errors.Errorf("error getting Azure blob %s properties: %w", path.Join(containerName, key), err)
+	}
+
+	return true, nil
+}
+
+// DeleteBlobIfNecessary deletes the specified blob with all its snapshots if it exists.
+func (client *Client) DeleteBlobIfNecessary(ctx context.Context, l log.Logger, containerName, key string) error {
+	if exists, err := client.DoesBlobExist(ctx, containerName, key); err != nil || !exists {
+		return err
+	}
+
+	description := fmt.Sprintf("Delete Azure blob %s in container %s with retry", key, containerName)
+
+	return util.DoWithRetry(ctx, description, azureMaxRetries, azureSleepBetweenRetries, l, log.DebugLevel, func(ctx context.Context) error {
+		return client.DeleteBlob(ctx, l, containerName, key)
+	})
+}
+
+// DeleteBlob deletes the specified blob with all its snapshots.
+func (client *Client) DeleteBlob(ctx context.Context, l log.Logger, containerName, key string) error {
+	l.Debugf("Deleting Azure blob %s in container %s", key, containerName)
+
+	opts := &blob.DeleteOptions{DeleteSnapshots: to.Ptr(blob.DeleteSnapshotsOptionTypeInclude)}
+
+	if _, err := client.blobClient(containerName, key).Delete(ctx, opts); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return errors.Errorf("failed to delete Azure blob %s in container %s: %w", key, containerName, err)
+	}
+
+	return nil
+}
+
+// MoveBlobIfNecessary moves the blob at the specified srcContainerName and srcKey to dstContainerName and dstKey of the dst client storage account.
+func (client *Client) MoveBlobIfNecessary(ctx context.Context, l log.Logger, dstClient *Client, srcContainerName, srcKey, dstContainerName, dstKey string) error {
+	if exists, err := client.DoesBlobExist(ctx, srcContainerName, srcKey); err != nil {
+		return err
+	} else if !exists {
+		l.Debugf("Remote state Azure blob %s does not exist or you don't have permissions to access it.", path.Join(srcContainerName, srcKey))
+
+		return nil
+	}
+
+	if exists, err := dstClient.DoesBlobExist(ctx, dstContainerName, dstKey); err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("destination Azure blob %s already exists", path.Join(dstContainerName, dstKey))
+	}
+
+	description := fmt.Sprintf("Move Azure blob from %s to %s", path.Join(srcContainerName, srcKey), path.Join(dstContainerName, dstKey))
+
+	return util.DoWithRetry(ctx, description, azureMaxRetries, azureSleepBetweenRetries, l, log.DebugLevel, func(ctx context.Context) error {
+		if err := client.CopyBlob(ctx, l, dstClient, srcContainerName, srcKey, dstContainerName, dstKey); err != nil {
+			return err
+		}
+
+		return client.DeleteBlob(ctx, l, srcContainerName, srcKey)
+	})
+}
+
+// CopyBlob copies the blob at the specified srcKey to dstKey. The content is streamed through Terragrunt, since the
+// source and destination blobs may belong to storage accounts with different credentials.
+func (client *Client) CopyBlob(ctx context.Context, l log.Logger, dstClient *Client, srcContainerName, srcKey, dstContainerName, dstKey string) error {
+	l.Debugf("Copying Azure blob from %s to %s", path.Join(srcContainerName, srcKey), path.Join(dstContainerName, dstKey))
+
+	resp, err := client.DownloadStream(ctx, srcContainerName, srcKey, nil)
+	if err != nil {
+		return errors.Errorf("failed to download Azure blob %s: %w", path.Join(srcContainerName, srcKey), err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return errors.Errorf("failed to read Azure blob %s: %w", path.Join(srcContainerName, srcKey), err)
+	}
+
+	if _, err := dstClient.UploadBuffer(ctx, dstContainerName, dstKey, buf.Bytes(), nil); err != nil {
+		return errors.Errorf("failed to upload Azure blob %s: %w", path.Join(dstContainerName, dstKey), err)
+	}
+
+	return nil
+}
+
+func (client *Client) containerClient(containerName string) *container.Client {
+	return client.ServiceClient().NewContainerClient(containerName)
+}
+
+func (client *Client) blobClient(containerName, key string) *blob.Client {
+	return client.containerClient(containerName).NewBlobClient(key)
+}
+
+// getTokenCredential returns the Azure AD credential, using the service principal secret if it is set in the config,
+// or the default credential chain (environment, workload identity, managed identity, Azure CLI) otherwise.
+func (client *Client) getTokenCredential() (azcore.TokenCredential, error) {
+	if client.tokenCredential != nil {
+		return client.tokenCredential, nil
+	}
+
+	var (
+		azCfg = client.RemoteStateConfigAzureRM
+		cred  azcore.TokenCredential
+		err   error
+	)
+
+	if azCfg.ClientID != "" && azCfg.ClientSecret != "" && azCfg.TenantID != "" {
+		cred, err = azidentity.NewClientSecretCredential(azCfg.TenantID, azCfg.ClientID, azCfg.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: client.clientOptions(),
+		})
+	} else {
+		cred, err = azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+			ClientOptions: client.clientOptions(),
+			TenantID:      azCfg.TenantID,
+		})
+	}
+
+	if err != nil {
+		return nil, errors.Errorf("error creating Azure credential: %w", err)
+	}
+
+	client.tokenCredential = cred
+
+	return cred, nil
+}
+
+func (client *Client) newResourceManagerClientFactory() (*armstorage.ClientFactory, error) {
+	cred, err := client.getTokenCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	factory, err := armstorage.NewClientFactory(client.RemoteStateConfigAzureRM.SubscriptionID, cred, &arm.ClientOptions{
+		ClientOptions: client.clientOptions(),
+	})
+	if err != nil {
+		return nil, errors.Errorf("error creating Azure Resource Manager client: %w", err)
+	}
+
+	return factory, nil
+}
+
+func (cfg *ExtendedRemoteStateConfigAzureRM) clientOptions() policy.ClientOptions {
+	return policy.ClientOptions{
+		Cloud: cloudConfigurations[cfg.RemoteStateConfigAzureRM.GetEnvironment()],
+	}
+}
+
+func isNotFoundError(err error) bool {
+	var respErr *azcore.ResponseError
+
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/mitchellh/mapstructure"
+)
+
+type Config map[string]any
+
+func (cfg Config) FilterOutTerragruntKeys() Config {
+	var filtered = make(Config)
+
+	for key, val := range cfg {
+		if slices.Contains(terragruntOnlyConfigs, key) {
+			continue
+		}
+
+		filtered[key] = val
+	}
+
+	return filtered
+}
+
+func (cfg Config) IsEqual(targetCfg Config, logger log.Logger) bool {
+	// If other keys in config are bools, DeepEqual also will consider the maps to be different.
+	for key, value := range targetCfg {
+		if util.KindOf(targetCfg[key]) == reflect.String && util.KindOf(cfg[key]) == reflect.Bool {
+			if convertedValue, err := strconv.ParseBool(value.(string)); err == nil {
+				targetCfg[key] = convertedValue
+			}
+		}
+	}
+
+	// Construct a new map excluding the keys that are only used in Terragrunt config and not in Terraform's backend
+	newConfig := backend.Config{}
+
+	maps.Copy(newConfig, cfg.FilterOutTerragruntKeys())
+
+	return newConfig.IsEqual(backend.Config(targetCfg), BackendName, logger)
+}
+
+// ParseExtendedAzureRMConfig parses the given map into an AzureRM config.
+func (cfg Config) ParseExtendedAzureRMConfig() (*ExtendedRemoteStateConfigAzureRM, error) {
+	var (
+		azureRMConfig  RemoteStateConfigAzureRM
+		extendedConfig ExtendedRemoteStateConfigAzureRM
+	)
+
+	if err := mapstructure.WeakDecode(cfg, &azureRMConfig); err != nil {
+		return nil, errors.New(err)
+	}
+
+	if err := mapstructure.WeakDecode(cfg, &extendedConfig); err != nil {
+		return nil, errors.New(err)
+	}
+
+	extendedConfig.RemoteStateConfigAzureRM = azureRMConfig
+
+	return &extendedConfig, nil
+}
+
+// ExtendedAzureRMConfig parses the given map into an extended AzureRM config and validates this config.
+func (cfg Config) ExtendedAzureRMConfig() (*ExtendedRemoteStateConfigAzureRM, error) {
+	extAzureRMCfg, err := cfg.ParseExtendedAzureRMConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return extAzureRMCfg, extAzureRMCfg.Validate()
+}
@@ -0,0 +1,178 @@
+package azurerm_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_IsEqual(t *testing.T) {
+	t.Parallel()
+
+	logger := logger.CreateLogger()
+
+	testCases := []struct { //nolint: govet
+		name          string
+		cfg           azurerm.Config
+		comparableCfg azurerm.Config
+		shouldBeEqual bool
+	}{
+		{
+			"equal-both-empty",
+			azurerm.Config{},
+			azurerm.Config{},
+			true,
+		},
+		{
+			"equal-one-key",
+			azurerm.Config{"storage_account_name": "foo"},
+			azurerm.Config{"storage_account_name": "foo"},
+			true,
+		},
+		{
+			"equal-general-bool-handling",
+			azurerm.Config{"use_azuread_auth": true},
+			azurerm.Config{"use_azuread_auth": "true"},
+			true,
+		},
+		{
+			"equal-ignore-storage-account-tags",
+			azurerm.Config{"key": "bar", "storage_account_tags": map[string]string{"foo": "bar"}},
+			azurerm.Config{"key": "bar"},
+			true,
+		},
+		{
+			"unequal-values",
+			azurerm.Config{"key": "bar"},
+			azurerm.Config{"key": "different"},
+			false,
+		},
+		{
+			"terragrunt-only-configs-remain-intact",
+			azurerm.Config{"key": "foo", "skip_storage_account_creation": true, "location": "westeurope"},
+			azurerm.Config{"key": "foo"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := tc.cfg.IsEqual(tc.comparableCfg, logger)
+			assert.Equal(t, tc.shouldBeEqual, actual)
+		})
+	}
+}
+
+func TestConfig_ExtendedAzureRMConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cfg                  azurerm.Config
+		name                 string
+		expectedErr          string
+		expectedBlobEndpoint string
+		expectedSKUName      string
+	}{
+		{
+			name: "defaults",
+			cfg: azurerm.Config{
+				"storage_account_name": "tfstate",
+				"container_name":       "state",
+				"key":                  "unit/terraform.tfstate",
+			},
+			expectedBlobEndpoint: "https://tfstate.blob.core.windows.net/",
+			expectedSKUName:      "Standard_LRS",
+		},
+		{
+			name: "china-environment-and-sku",
+			cfg: azurerm.Config{
+				"storage_account_name":     "tfstate",
+				"container_name":           "state",
+				"key":                      "unit/terraform.tfstate",
+				"environment":              "China",
+				"account_tier":             "Premium",
+				"account_replication_type": "ZRS",
+			},
+			expectedBlobEndpoint: "https://tfstate.blob.core.chinacloudapi.cn/",
+			expectedSKUName:      "Premium_ZRS",
+		},
+		{
+			name: "custom-blob-endpoint",
+			cfg: azurerm.Config{
+				"storage_account_name": "devstoreaccount1",
+				"container_name":       "state",
+				"key":                  "unit/terraform.tfstate",
+				"blob_endpoint":        "http://127.0.0.1:10000/devstoreaccount1",
+			},
+			expectedBlobEndpoint: "http://127.0.0.1:10000/devstoreaccount1/",
+			expectedSKUName:      "Standard_LRS",
+		},
+		{
+			name: "missing-container-name",
+			cfg: azurerm.Config{
+				"storage_account_name": "tfstate",
+				"key":                  "unit/terraform.tfstate",
+			},
+			expectedErr: "Missing required AzureRM remote state configuration container_name",
+		},
+		{
+			name: "unsupported-environment",
+			cfg: azurerm.Config{
+				"storage_account_name": "tfstate",
+				"container_name":       "state",
+				"key":                  "unit/terraform.tfstate",
+				"environment":          "mars",
+			},
+			expectedErr: `Unsupported Azure environment "mars"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			extCfg, err := tc.cfg.ExtendedAzureRMConfig()
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBlobEndpoint, extCfg.GetBlobEndpoint())
+			assert.Equal(t, tc.expectedSKUName, extCfg.GetSKUName())
+		})
+	}
+}
+
+func TestBackend_GetTFInitArgs(t *testing.T) {
+	t.Parallel()
+
+	cfg := map[string]any{
+		"storage_account_name":          "tfstate",
+		"container_name":                "state",
+		"key":                           "unit/terraform.tfstate",
+		"resource_group_name":           "rg",
+		"location":                      "westeurope",
+		"storage_account_tags":          map[string]string{"owner": "terragrunt"},
+		"skip_storage_account_creation": true,
+		"skip_container_creation":       true,
+		"skip_blob_versioning":          true,
+		"blob_endpoint":                 "http://127.0.0.1:10000/devstoreaccount1",
+	}
+
+	actual := azurerm.NewBackend().GetTFInitArgs(cfg)
+
+	assert.Equal(t, map[string]any{
+		"storage_account_name": "tfstate",
+		"container_name":       "state",
+		"key":                  "unit/terraform.tfstate",
+		"resource_group_name":  "rg",
+	}, actual)
+}
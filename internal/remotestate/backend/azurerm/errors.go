@@ -0,0 +1,15 @@
+package azurerm
+
+import "fmt"
+
+type MissingRequiredAzureRMRemoteStateConfig string
+
+func (configName MissingRequiredAzureRMRemoteStateConfig) Error() string {
+	return "Missing required AzureRM remote state configuration " + string(configName)
+}
+
+type UnsupportedAzureEnvironment string
+
+func (env UnsupportedAzureEnvironment) Error() string {
+	return fmt.Sprintf("Unsupported Azure environment %q, use one of public, china, usgovernment or set blob_endpoint", string(env))
+}
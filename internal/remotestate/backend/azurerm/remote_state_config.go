@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+const (
+	defaultAccountKind            = "StorageV2"
+	defaultAccountTier            = "Standard"
+	defaultAccountReplicationType = "LRS"
+
+	defaultEnvironment = "public"
+)
+
+// These are settings that can appear in the remote_state config that are ONLY used by Terragrunt and NOT forwarded
+// to the underlying Terraform backend configuration.
+var terragruntOnlyConfigs = []string{
+	"location",
+	"storage_account_tags",
+	"account_kind",
+	"account_tier",
+	"account_replication_type",
+	"blob_endpoint",
+	"skip_storage_account_creation",
+	"skip_container_creation",
+	"skip_blob_versioning",
+}
+
+// storageEndpointSuffixes maps the `environment` values supported by the azurerm backend to the storage endpoint suffix.
+var storageEndpointSuffixes = map[string]string{
+	"public":       "core.windows.net",
+	"china":        "core.chinacloudapi.cn",
+	"usgovernment": "core.usgovcloudapi.net",
+}
+
+/* ExtendedRemoteStateConfigAzureRM is a struct that contains the Azure specific configuration options.
+ *
+ * We use this construct to separate the config keys that are only used by terragrunt to create the storage
+ * account and container, in case it has to create them, from the keys that are passed to the azurerm backend.
+ */
+type ExtendedRemoteStateConfigAzureRM struct {
+	StorageAccountTags         map[string]string        `mapstructure:"storage_account_tags"`
+	Location                   string                   `mapstructure:"location"`
+	AccountKind                string                   `mapstructure:"account_kind"`
+	AccountTier                string                   `mapstructure:"account_tier"`
+	AccountReplicationType     string                   `mapstructure:"account_replication_type"`
+	BlobEndpoint               string                   `mapstructure:"blob_endpoint"`
+	RemoteStateConfigAzureRM   RemoteStateConfigAzureRM `mapstructure:",squash"`
+	SkipStorageAccountCreation bool                     `mapstructure:"skip_storage_account_creation"`
+	SkipContainerCreation      bool                     `mapstructure:"skip_container_creation"`
+	SkipBlobVersioning         bool                     `mapstructure:"skip_blob_versioning"`
+}
+
+// Validate validates the configuration for AzureRM remote state.
+func (cfg *ExtendedRemoteStateConfigAzureRM) Validate() error {
+	azCfg := cfg.RemoteStateConfigAzureRM
+
+	if azCfg.StorageAccountName == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("storage_account_name"))
+	}
+
+	if azCfg.ContainerName == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("container_name"))
+	}
+
+	if azCfg.Key == "" {
+		return errors.New(MissingRequiredAzureRMRemoteStateConfig("key"))
+	}
+
+	if _, ok := storageEndpointSuffixes[azCfg.GetEnvironment()]; !ok && cfg.BlobEndpoint == "" {
+		return errors.New(UnsupportedAzureEnvironment(azCfg.Environment))
+	}
+
+	return nil
+}
+
+// GetBlobEndpoint returns the URL of the blob service of the configured storage account.
+func (cfg *ExtendedRemoteStateConfigAzureRM) GetBlobEndpoint() string {
+	if cfg.BlobEndpoint != "" {
+		return strings.TrimSuffix(cfg.BlobEndpoint, "/") + "/"
+	}
+
+	suffix := storageEndpointSuffixes[cfg.RemoteStateConfigAzureRM.GetEnvironment()]
+
+	return fmt.Sprintf("https://%s.blob.%s/", cfg.RemoteStateConfigAzureRM.StorageAccountName, suffix)
+}
+
+// GetAccountKind returns the kind of the storage account that is created, `StorageV2` by default.
+func (cfg *ExtendedRemoteStateConfigAzureRM) GetAccountKind() string {
+	if cfg.AccountKind != "" {
+		return cfg.AccountKind
+	}
+
+	return defaultAccountKind
+}
+
+// GetSKUName returns the SKU name of the storage account that is created, e.g. `Standard_LRS`.
+func (cfg *ExtendedRemoteStateConfigAzureRM) GetSKUName() string {
+	tier, replication := cfg.AccountTier, cfg.AccountReplicationType
+
+	if tier == "" {
+		tier = defaultAccountTier
+	}
+
+	if replication == "" {
+		replication = defaultAccountReplicationType
+	}
+
+	return tier + "_" + replication
+}
+
+// CanManageStorageAccount returns true if the config contains enough information to use the Azure Resource Manager API,
+// which is required to create the storage account and to manage blob versioning.
+func (cfg *ExtendedRemoteStateConfigAzureRM) CanManageStorageAccount() bool {
+	return cfg.RemoteStateConfigAzureRM.SubscriptionID != "" && cfg.RemoteStateConfigAzureRM.ResourceGroupName != ""
+}
+
+// RemoteStateConfigAzureRM is a representation of the configuration
+// options available for AzureRM remote state.
+type RemoteStateConfigAzureRM struct {
+	StorageAccountName string `mapstructure:"storage_account_name"`
+	ContainerName      string `mapstructure:"container_name"`
+	Key                string `mapstructure:"key"`
+	ResourceGroupName  string `mapstructure:"resource_group_name"`
+	SubscriptionID     string `mapstructure:"subscription_id"`
+	TenantID           string `mapstructure:"tenant_id"`
+	ClientID           string `mapstructure:"client_id"`
+	ClientSecret       string `mapstructure:"client_secret"`
+	AccessKey          string `mapstructure:"access_key"`
+	SASToken           string `mapstructure:"sas_token"`
+	Environment        string `mapstructure:"environment"`
+	UseAzureADAuth     bool   `mapstructure:"use_azuread_auth"`
+	UseMSI             bool   `mapstructure:"use_msi"`
+	UseOIDC            bool   `mapstructure:"use_oidc"`
+}
+
+// GetEnvironment returns the Azure cloud environment, `public` by default.
+func (cfg *RemoteStateConfigAzureRM) GetEnvironment() string {
+	if cfg.Environment != "" {
+		return strings.ToLower(cfg.Environment)
+	}
+
+	return defaultEnvironment
+}
+
+// CacheKey returns a unique key for the given AzureRM config that can be used to cache the initialization.
+func (cfg *RemoteStateConfigAzureRM) CacheKey() string {
+	return cfg.StorageAccountName + "/" + cfg.ContainerName
+}
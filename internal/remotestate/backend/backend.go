@@ -43,6 +43,27 @@ type Backend interface {
 	// DeleteBucket deletes the entire bucket.
 	DeleteBucket(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) error
 
+	// Verify compares the remote state resources with the given config and returns every mismatch. It never modifies the resources.
+	Verify(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (Drifts, error)
+
+	// GetLock returns the lock currently held on the remote state, or nil if the state is not locked.
+	GetLock(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (*LockInfo, error)
+
+	// ReleaseLock force-releases the lock held on the remote state, regardless of its holder.
+	ReleaseLock(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) error
+
+	// GetSnapshotStore returns the store that keeps the state snapshots under the given prefix of the backend bucket.
+	GetSnapshotStore(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, prefix string) (SnapshotStore, error)
+
+	// GetStateLocation returns the place in the backend bucket where the states of the unit are stored.
+	GetStateLocation(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (*StateLocation, error)
+
+	// ListStateObjects returns all the state objects with the given key prefix in the backend bucket.
+	ListStateObjects(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, prefix string) ([]*StateObject, error)
+
+	// DeleteStateObject deletes the state object with the given key from the backend bucket.
+	DeleteStateObject(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, key string) error
+
 	// GetTFInitArgs returns the config that should be passed on to `tofu -backend-config` cmd line param
 	// Allows the Backends to filter and/or modify the configuration given from the user.
 	GetTFInitArgs(config Config) map[string]any
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/puzpuzpuz/xsync/v3"
@@ -69,6 +70,52 @@ func (backend *CommonBackend) DeleteBucket(ctx context.Context, l log.Logger, co
 	return nil
 }
 
+// Verify implements `backends.Verify` interface. It returns an error rather than no drifts, so that backends without
+// verification are not reported as free of drift.
+func (backend *CommonBackend) Verify(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (Drifts, error) {
+	return nil, errors.Errorf("verification is not supported for the %s backend", backend.Name())
+}
+
+// GetLock implements `backends.GetLock` interface.
+func (backend *CommonBackend) GetLock(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (*LockInfo, error) {
+	l.Warnf("Getting state lock for %s backend not implemented.", backend.Name())
+
+	return nil, nil
+}
+
+// ReleaseLock implements `backends.ReleaseLock` interface.
+func (backend *CommonBackend) ReleaseLock(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) error {
+	l.Warnf("Releasing state lock for %s backend not implemented.", backend.Name())
+
+	return nil
+}
+
+// GetSnapshotStore implements `backends.GetSnapshotStore` interface.
+func (backend *CommonBackend) GetSnapshotStore(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, prefix string) (SnapshotStore, error) {
+	return nil, errors.Errorf("storing state snapshots in a bucket is not supported by the %s backend", backend.Name())
+}
+
+// GetStateLocation implements `backends.GetStateLocation` interface.
+func (backend *CommonBackend) GetStateLocation(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions) (*StateLocation, error) {
+	l.Warnf("Getting state location for %s backend not implemented.", backend.Name())
+
+	return nil, nil
+}
+
+// ListStateObjects implements `backends.ListStateObjects` interface.
+func (backend *CommonBackend) ListStateObjects(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, prefix string) ([]*StateObject, error) {
+	l.Warnf("Listing state objects for %s backend not implemented.", backend.Name())
+
+	return nil, nil
+}
+
+// DeleteStateObject implements `backends.DeleteStateObject` interface.
+func (backend *CommonBackend) DeleteStateObject(ctx context.Context, l log.Logger, config Config, opts *options.TerragruntOptions, key string) error {
+	l.Warnf("Deleting state object for %s backend not implemented.", backend.Name())
+
+	return nil
+}
+
 // GetTFInitArgs implements `backends.GetTFInitArgs` interface.
 func (backend *CommonBackend) GetTFInitArgs(config Config) map[string]any {
 	return config
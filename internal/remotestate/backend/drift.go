@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"fmt"
+)
+
+const (
+	DriftValueEnabled  = "enabled"
+	DriftValueDisabled = "disabled"
+	DriftValueExists   = "exists"
+	DriftValueMissing  = "missing"
+)
+
+// Drift is a mismatch between the declared `remote_state` config and the actual backend infrastructure.
+type Drift struct {
+	// Resource is the backend resource that has drifted, e.g. `S3 bucket my-bucket`.
+	Resource string `json:"resource"`
+	// Setting is the name of the drifted setting, e.g. `versioning`.
+	Setting  string `json:"setting"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	// Fixed is true if the drift has been reconciled.
+	Fixed bool `json:"fixed"`
+}
+
+// NewDrift returns a new `Drift` instance.
+func NewDrift(resource, setting, expected, actual string) *Drift {
+	return &Drift{
+		Resource: resource,
+		Setting:  setting,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// NewMissingResourceDrift returns a drift for a resource that is declared in the config but does not exist.
+func NewMissingResourceDrift(resource string) *Drift {
+	return NewDrift(resource, "existence", DriftValueExists, DriftValueMissing)
+}
+
+// NewDisabledSettingDrift returns a drift for a setting that is expected to be enabled but is disabled.
+func NewDisabledSettingDrift(resource, setting string) *Drift {
+	return NewDrift(resource, setting, DriftValueEnabled, DriftValueDisabled)
+}
+
+// String implements `fmt.Stringer` interface.
+func (drift *Drift) String() string {
+	return fmt.Sprintf("%s: %s is %s, expected %s", drift.Resource, drift.Setting, drift.Actual, drift.Expected)
+}
+
+// Key returns the key that identifies the drifted setting of the resource.
+func (drift *Drift) Key() string {
+	return drift.Resource + "/" + drift.Setting
+}
+
+type Drifts []*Drift
+
+// MarkFixed marks as fixed all drifts that are not present in the given `remaining` drifts.
+func (drifts Drifts) MarkFixed(remaining Drifts) {
+	keys := make(map[string]struct{}, len(remaining))
+
+	for _, drift := range remaining {
+		keys[drift.Key()] = struct{}{}
+	}
+
+	for _, drift := range drifts {
+		if _, ok := keys[drift.Key()]; !ok {
+			drift.Fixed = true
+		}
+	}
+}
+
+// Unfixed returns the drifts that have not been reconciled.
+func (drifts Drifts) Unfixed() Drifts {
+	var unfixed Drifts
+
+	for _, drift := range drifts {
+		if !drift.Fixed {
+			unfixed = append(unfixed, drift)
+		}
+	}
+
+	return unfixed
+}
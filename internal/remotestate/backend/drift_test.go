@@ -0,0 +1,33 @@
+package backend_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrifts_MarkFixed(t *testing.T) {
+	t.Parallel()
+
+	versioning := backend.NewDisabledSettingDrift("S3 bucket test", "versioning")
+	table := backend.NewMissingResourceDrift("DynamoDB table test")
+	drifts := backend.Drifts{versioning, table}
+
+	drifts.MarkFixed(backend.Drifts{backend.NewMissingResourceDrift("DynamoDB table test")})
+
+	assert.True(t, versioning.Fixed)
+	assert.False(t, table.Fixed)
+	assert.Equal(t, backend.Drifts{table}, drifts.Unfixed())
+	assert.Equal(t, "S3 bucket test: versioning is disabled, expected enabled", versioning.String())
+}
+
+func TestCommonBackend_VerifyNotSupported(t *testing.T) {
+	t.Parallel()
+
+	drifts, err := backend.NewCommonBackend("local").Verify(t.Context(), logger.CreateLogger(), backend.Config{}, nil)
+	require.EqualError(t, err, "verification is not supported for the local backend")
+	assert.Nil(t, drifts)
+}
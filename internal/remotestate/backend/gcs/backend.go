@@ -16,6 +16,10 @@ const (
 	BackendName = "gcs"
 
 	defaultTfState = "default.tfstate"
+	defaultTfLock  = "default.tflock"
+
+	// stateFileSuffix is the suffix of the state objects, named after their workspaces.
+	stateFileSuffix = ".tfstate"
 )
 
 var _ backend.Backend = new(Backend)
@@ -215,8 +219,156 @@ func (backend *Backend) DeleteBucket(ctx context.Context, l log.Logger, backendC
 	return nil
 }
 
+// Verify compares the GCS bucket specified in the given config with its actual settings and returns every mismatch.
+func (backend *Backend) Verify(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (backend.Drifts, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.VerifyGCSBucket(ctx, extGCSCfg.RemoteStateConfigGCS.Bucket)
+}
+
+// GetLock returns the state lock held in the `.tflock` object next to the state object.
+func (backend *Backend) GetLock(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (*backend.LockInfo, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	lockKey := path.Join(extGCSCfg.RemoteStateConfigGCS.Prefix, defaultTfLock)
+
+	return client.GetGCSObjectLockInfo(ctx, extGCSCfg.RemoteStateConfigGCS.Bucket, lockKey)
+}
+
+// ReleaseLock deletes the `.tflock` object next to the state object.
+func (backend *Backend) ReleaseLock(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	var (
+		bucketName = extGCSCfg.RemoteStateConfigGCS.Bucket
+		lockKey    = path.Join(extGCSCfg.RemoteStateConfigGCS.Prefix, defaultTfLock)
+	)
+
+	if exists, err := client.DoesGCSObjectExistWithLogging(ctx, l, bucketName, lockKey); err != nil || !exists {
+		return err
+	}
+
+	return client.DeleteGCSObjects(ctx, l, bucketName, lockKey, false)
+}
+
+// GetSnapshotStore returns the store that keeps the state snapshots in the GCS bucket under `<prefix>/<state-prefix>/`.
+func (backend *Backend) GetSnapshotStore(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, prefix string) (backend.SnapshotStore, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshotStore(client, extGCSCfg.RemoteStateConfigGCS.Bucket, path.Join(prefix, extGCSCfg.RemoteStateConfigGCS.Prefix)), nil
+}
+
+// GetStateLocation returns the place in the GCS bucket where the states of the unit, one per workspace, are stored.
+func (backend *Backend) GetStateLocation(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (*backend.StateLocation, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newStateLocation(extGCSCfg.RemoteStateConfigGCS.Bucket, extGCSCfg.RemoteStateConfigGCS.Prefix), nil
+}
+
+// ListStateObjects returns all the state objects with the given name prefix in the GCS bucket.
+func (backend *Backend) ListStateObjects(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, prefix string) ([]*backend.StateObject, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.ListGCSStateObjects(ctx, extGCSCfg.RemoteStateConfigGCS.Bucket, prefix)
+}
+
+// DeleteStateObject deletes the state object with the given name from the GCS bucket.
+func (backend *Backend) DeleteStateObject(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, key string) error {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.DeleteGCSObject(ctx, l, extGCSCfg.RemoteStateConfigGCS.Bucket, key)
+}
+
 // GetTFInitArgs returns the subset of the given config that should be passed to terraform init
 // when initializing the remote state.
 func (backend *Backend) GetTFInitArgs(config backend.Config) map[string]any {
 	return Config(config).FilterOutTerragruntKeys()
 }
+
+func newStateLocation(bucket, prefix string) *backend.StateLocation {
+	return &backend.StateLocation{
+		Bucket:      bucket,
+		KeyPatterns: []string{path.Join(backend.EscapeKeyPattern(prefix), "*"+stateFileSuffix)},
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -175,6 +177,36 @@ func (client *Client) CheckIfGCSVersioningEnabled(ctx context.Context, l log.Log
 	return attrs.VersioningEnabled, nil
 }
 
+// VerifyGCSBucket compares the settings of the given GCS bucket with the config and returns every mismatch.
+func (client *Client) VerifyGCSBucket(ctx context.Context, bucketName string) (backend.Drifts, error) {
+	resource := "GCS bucket " + bucketName
+
+	if !client.DoesGCSBucketExist(ctx, bucketName) {
+		return backend.Drifts{backend.NewMissingResourceDrift(resource)}, nil
+	}
+
+	attrs, err := client.Bucket(bucketName).Attrs(ctx)
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	var drifts backend.Drifts
+
+	if !client.SkipBucketVersioning && !attrs.VersioningEnabled {
+		drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "versioning"))
+	}
+
+	for key, value := range client.GCSBucketLabels {
+		if actual, ok := attrs.Labels[key]; !ok {
+			drifts = append(drifts, backend.NewDrift(resource, "label "+key, value, backend.DriftValueMissing))
+		} else if actual != value {
+			drifts = append(drifts, backend.NewDrift(resource, "label "+key, value, actual))
+		}
+	}
+
+	return drifts, nil
+}
+
 // CreateGCSBucketWithVersioning creates the given GCS bucket and enables versioning for it.
 func (client *Client) CreateGCSBucketWithVersioning(ctx context.Context, l log.Logger, bucketName string) error {
 	if err := client.CreateGCSBucket(ctx, l, bucketName); err != nil {
@@ -437,6 +469,69 @@ func (client *Client) DoesGCSObjectExistWithLogging(ctx context.Context, l log.L
 	return false, nil
 }
 
+// GetGCSObjectLockInfo returns the state lock stored in the given GCS object, or nil if the object does not exist.
+func (client *Client) GetGCSObjectLockInfo(ctx context.Context, bucketName, key string) (*backend.LockInfo, error) {
+	reader, err := client.Bucket(bucketName).Object(key).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist) {
+			return nil, nil
+		}
+
+		return nil, errors.Errorf("failed to read GCS object %s in bucket %s: %w", key, bucketName, err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Errorf("failed to read GCS object %s in bucket %s: %w", key, bucketName, err)
+	}
+
+	return backend.ParseLockInfo(data)
+}
+
+// DeleteGCSObject deletes the GCS object with the specified key from the given bucket.
+func (client *Client) DeleteGCSObject(ctx context.Context, l log.Logger, bucketName, key string) error {
+	l.Debugf("Deleting GCS object %s in bucket %s", key, bucketName)
+
+	if err := client.Bucket(bucketName).Object(key).Delete(ctx); err != nil {
+		return errors.Errorf("failed to delete object %s in bucket %s: %w", key, bucketName, err)
+	}
+
+	return nil
+}
+
+// ListGCSStateObjects returns all the state objects with the given name prefix in the given bucket.
+func (client *Client) ListGCSStateObjects(ctx context.Context, bucketName, prefix string) ([]*backend.StateObject, error) {
+	var objects []*backend.StateObject
+
+	it := client.Bucket(bucketName).Objects(ctx, &storage.Query{Prefix: prefix})
+
+	for {
+		attrs, err := it.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+
+			return nil, errors.Errorf("failed to list state objects in GCS bucket %s: %w", bucketName, err)
+		}
+
+		if !strings.HasSuffix(attrs.Name, stateFileSuffix) {
+			continue
+		}
+
+		objects = append(objects, &backend.StateObject{
+			Bucket:       bucketName,
+			Key:          attrs.Name,
+			Size:         attrs.Size,
+			LastModified: attrs.Updated,
+		})
+	}
+
+	return objects, nil
+}
+
 // MoveGCSObject copies the GCS object at the specified srcKey to dstKey and then removes srcKey.
 func (client *Client) MoveGCSObject(ctx context.Context, l log.Logger, srcBucketName, srcKey, dstBucketName, dstKey string) error {
 	if err := client.CopyGCSBucketObject(ctx, l, srcBucketName, srcKey, dstBucketName, dstKey); err != nil {
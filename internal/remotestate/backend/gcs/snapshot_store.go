@@ -0,0 +1,94 @@
+package gcs
+
+import (
+	"context"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"google.golang.org/api/iterator"
+)
+
+var _ backend.SnapshotObjects = new(snapshotObjects)
+
+// snapshotObjects reads and writes the objects of the state snapshots in a GCS bucket.
+type snapshotObjects struct {
+	client *Client
+	bucket string
+}
+
+// NewSnapshotStore returns a new snapshot store that keeps the snapshots as objects under the given prefix of the bucket.
+func NewSnapshotStore(client *Client, bucket, prefix string) *backend.BucketSnapshotStore {
+	return backend.NewBucketSnapshotStore(&snapshotObjects{client: client, bucket: bucket}, prefix)
+}
+
+// PutObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) PutObject(ctx context.Context, l log.Logger, key string, data []byte) error {
+	l.Debugf("Saving state snapshot to GCS bucket %s object %s", objects.bucket, key)
+
+	writer := objects.client.Bucket(objects.bucket).Object(key).NewWriter(ctx)
+
+	if _, err := writer.Write(data); err != nil {
+		writer.Close() //nolint:errcheck,gosec
+
+		return errors.Errorf("failed to write GCS bucket %s object %s: %w", objects.bucket, key, err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return errors.Errorf("failed to write GCS bucket %s object %s: %w", objects.bucket, key, err)
+	}
+
+	return nil
+}
+
+// ListObjects implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) ListObjects(ctx context.Context, l log.Logger, prefix string) ([]string, error) {
+	var keys []string
+
+	it := objects.client.Bucket(objects.bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+
+	for {
+		attrs, err := it.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+
+			return nil, errors.Errorf("failed to list GCS bucket %s prefix %s: %w", objects.bucket, prefix, err)
+		}
+
+		keys = append(keys, attrs.Name)
+	}
+
+	return keys, nil
+}
+
+// GetObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) GetObject(ctx context.Context, l log.Logger, key string) ([]byte, error) {
+	reader, err := objects.client.Bucket(objects.bucket).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to read GCS bucket %s object %s: %w", objects.bucket, key, err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Errorf("failed to read GCS bucket %s object %s: %w", objects.bucket, key, err)
+	}
+
+	return data, nil
+}
+
+// DeleteObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) DeleteObject(ctx context.Context, l log.Logger, key string) error {
+	l.Debugf("Deleting state snapshot from GCS bucket %s object %s", objects.bucket, key)
+
+	if err := objects.client.Bucket(objects.bucket).Object(key).Delete(ctx); err != nil {
+		return errors.Errorf("failed to delete GCS bucket %s object %s: %w", objects.bucket, key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+// Package http represents the generic HTTP backend for interacting with remote state
+// stored by services that implement the OpenTofu/Terraform `http` backend protocol.
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/shell"
+)
+
+const (
+	BackendName = "http"
+
+	lockOperationMigrate = "migrate"
+	lockOperationDelete  = "delete"
+)
+
+var _ backend.Backend = new(Backend)
+
+type Backend struct {
+	*backend.CommonBackend
+}
+
+func NewBackend() *Backend {
+	return &Backend{
+		CommonBackend: backend.NewCommonBackend(BackendName),
+	}
+}
+
+// NeedsBootstrap returns true if the configured state endpoint does not respond with either existing state or no state.
+func (backend *Backend) NeedsBootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (bool, error) {
+	httpCfg, err := Config(backendConfig).HTTPConfig()
+	if err != nil {
+		return false, err
+	}
+
+	client, err := NewClient(l, httpCfg)
+	if err != nil {
+		return false, err
+	}
+
+	return !client.IsEndpointAvailable(ctx, l), nil
+}
+
+// Bootstrap validates the config parameters and checks that the configured state endpoint is available.
+// There is no infrastructure to create for HTTP backends, so the endpoint must already be running.
+func (backend *Backend) Bootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	httpCfg, err := Config(backendConfig).HTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	if backend.IsConfigInited(httpCfg) {
+		l.Debugf("%s endpoint %s has already been confirmed to be available, skipping initialization checks", backend.Name(), httpCfg.Address)
+
+		return nil
+	}
+
+	client, err := NewClient(l, httpCfg)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := client.GetState(ctx); err != nil {
+		return errors.Errorf("HTTP remote state endpoint %s is not available: %w", httpCfg.Address, err)
+	}
+
+	backend.MarkConfigInited(httpCfg)
+
+	return nil
+}
+
+// Migrate copies the state stored at the src address to the dst address and deletes the src state.
+// Both states are locked during the migration if locking is configured.
+func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *options.TerragruntOptions) (err error) {
+	srcHTTPCfg, err := Config(srcBackendConfig).HTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	dstHTTPCfg, err := Config(dstBackendConfig).HTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	srcClient, err := NewClient(l, srcHTTPCfg)
+	if err != nil {
+		return err
+	}
+
+	dstClient, err := NewClient(l, dstHTTPCfg)
+	if err != nil {
+		return err
+	}
+
+	srcLock := NewLockInfo(lockOperationMigrate)
+	if err := srcClient.Lock(ctx, l, srcLock); err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, srcClient.Unlock(ctx, l, srcLock))
+	}()
+
+	dstLock := NewLockInfo(lockOperationMigrate)
+	if err := dstClient.Lock(ctx, l, dstLock); err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, dstClient.Unlock(ctx, l, dstLock))
+	}()
+
+	data, exists, err := srcClient.GetState(ctx)
+	if err != nil {
+		return err
+	} else if !exists {
+		l.Debugf("Remote state %s does not exist or you don't have permissions to access it.", srcHTTPCfg.Address)
+
+		return nil
+	}
+
+	if _, exists, err := dstClient.GetState(ctx); err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("destination HTTP remote state %s already exists", dstHTTPCfg.Address)
+	}
+
+	l.Debugf("Copying HTTP remote state from %s to %s", srcHTTPCfg.Address, dstHTTPCfg.Address)
+
+	var dstLockID string
+	if dstClient.IsLockingEnabled() {
+		dstLockID = dstLock.ID
+	}
+
+	if err := dstClient.PutState(ctx, data, dstLockID); err != nil {
+		return err
+	}
+
+	return srcClient.DeleteState(ctx)
+}
+
+// Verify checks that the configured state endpoint is available. There are no other settings to compare for HTTP backends.
+func (backend *Backend) Verify(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (backend.Drifts, error) {
+	httpCfg, err := Config(backendConfig).HTTPConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(l, httpCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.VerifyEndpoint(ctx, l), nil
+}
+
+// Delete deletes the remote state specified in the given config.
+func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (err error) {
+	httpCfg, err := Config(backendConfig).HTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(l, httpCfg)
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("HTTP remote state %s will be deleted. Do you want to continue?", httpCfg.Address)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil || !yes {
+		return err
+	}
+
+	lock := NewLockInfo(lockOperationDelete)
+	if err := client.Lock(ctx, l, lock); err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errors.Join(err, client.Unlock(ctx, l, lock))
+	}()
+
+	return client.DeleteState(ctx)
+}
@@ -0,0 +1,204 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	httpbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/http"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stateServer is a minimal in-memory implementation of the OpenTofu/Terraform http backend protocol.
+type stateServer struct {
+	states map[string][]byte
+	locks  map[string]string
+	mu     sync.Mutex
+}
+
+func newStateServer(t *testing.T) (*stateServer, *httptest.Server) {
+	t.Helper()
+
+	srv := &stateServer{
+		states: make(map[string][]byte),
+		locks:  make(map[string]string),
+	}
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	return srv, ts
+}
+
+func (srv *stateServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	key := r.URL.Path
+
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := srv.states[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write(data) //nolint:errcheck
+	case http.MethodPost:
+		if lockID, ok := srv.locks[key]; ok && lockID != r.URL.Query().Get("ID") {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		srv.states[key] = data
+	case http.MethodDelete:
+		delete(srv.states, key)
+	case "LOCK":
+		info := &backend.LockInfo{}
+		if err := json.NewDecoder(r.Body).Decode(info); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if _, ok := srv.locks[key]; ok {
+			w.WriteHeader(http.StatusLocked)
+			json.NewEncoder(w).Encode(&backend.LockInfo{ID: srv.locks[key], Who: "someone"}) //nolint:errcheck
+
+			return
+		}
+
+		srv.locks[key] = info.ID
+	case "UNLOCK":
+		delete(srv.locks, key)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestOptions(t *testing.T) *options.TerragruntOptions {
+	t.Helper()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	return opts
+}
+
+func backendConfig(address string) backend.Config {
+	return backend.Config{
+		"address":        address,
+		"lock_address":   address,
+		"unlock_address": address,
+		"retry_max":      0,
+	}
+}
+
+func TestBackend_NeedsBootstrap(t *testing.T) {
+	t.Parallel()
+
+	_, ts := newStateServer(t)
+
+	var (
+		ctx  = context.Background()
+		l    = logger.CreateLogger()
+		opts = newTestOptions(t)
+	)
+
+	needsBootstrap, err := httpbackend.NewBackend().NeedsBootstrap(ctx, l, backendConfig(ts.URL+"/unit"), opts)
+	require.NoError(t, err)
+	assert.False(t, needsBootstrap)
+
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer unavailable.Close()
+
+	needsBootstrap, err = httpbackend.NewBackend().NeedsBootstrap(ctx, l, backendConfig(unavailable.URL+"/unit"), opts)
+	require.NoError(t, err)
+	assert.True(t, needsBootstrap)
+
+	err = httpbackend.NewBackend().Bootstrap(ctx, l, backendConfig(unavailable.URL+"/unit"), opts)
+	require.Error(t, err)
+}
+
+func TestBackend_MigrateAndDelete(t *testing.T) {
+	t.Parallel()
+
+	srv, ts := newStateServer(t)
+
+	var (
+		ctx       = context.Background()
+		l         = logger.CreateLogger()
+		opts      = newTestOptions(t)
+		httpBknd  = httpbackend.NewBackend()
+		srcConfig = backendConfig(ts.URL + "/src")
+		dstConfig = backendConfig(ts.URL + "/dst")
+	)
+
+	srv.states["/src"] = []byte(`{"version": 4}`)
+
+	require.NoError(t, httpBknd.Migrate(ctx, l, srcConfig, dstConfig, opts))
+
+	assert.NotContains(t, srv.states, "/src")
+	assert.JSONEq(t, `{"version": 4}`, string(srv.states["/dst"]))
+	assert.Empty(t, srv.locks)
+
+	srv.states["/src"] = []byte(`{"version": 4}`)
+
+	err := httpBknd.Migrate(ctx, l, srcConfig, dstConfig, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+	assert.Empty(t, srv.locks)
+
+	require.NoError(t, httpBknd.Delete(ctx, l, dstConfig, opts))
+	assert.NotContains(t, srv.states, "/dst")
+}
+
+func TestBackend_DeleteLockedState(t *testing.T) {
+	t.Parallel()
+
+	srv, ts := newStateServer(t)
+
+	srv.states["/unit"] = []byte(`{"version": 4}`)
+	srv.locks["/unit"] = "existing-lock"
+
+	err := httpbackend.NewBackend().Delete(context.Background(), logger.CreateLogger(), backendConfig(ts.URL+"/unit"), newTestOptions(t))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "already locked by someone"), err.Error())
+	assert.Contains(t, srv.states, "/unit")
+}
+
+func TestConfig_HTTPConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := httpbackend.Config{}.HTTPConfig()
+	require.Error(t, err)
+
+	cfg, err := httpbackend.Config{"address": "https://state.example.com/unit", "retry_max": float64(5)}.HTTPConfig()
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, cfg.UpdateMethod)
+	assert.Equal(t, "LOCK", cfg.LockMethod)
+	assert.Equal(t, "UNLOCK", cfg.UnlockMethod)
+	assert.Equal(t, 5, cfg.RetryMax)
+	assert.Equal(t, 1, cfg.RetryWaitMin)
+	assert.Equal(t, 30, cfg.RetryWaitMax)
+	assert.False(t, cfg.IsLockingEnabled())
+
+	cfg, err = httpbackend.Config{"address": "https://state.example.com/unit", "retry_max": float64(0), "retry_wait_min": nil}.HTTPConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.RetryMax)
+	assert.Equal(t, 1, cfg.RetryWaitMin)
+}
@@ -0,0 +1,311 @@
+package http
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/user"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/go-commons/version"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// NewLockInfo returns a new lock info with a random ID for the given operation.
+func NewLockInfo(operation string) *backend.LockInfo {
+	who := "terragrunt"
+
+	if u, err := user.Current(); err == nil {
+		who = u.Username
+	}
+
+	if host, err := os.Hostname(); err == nil {
+		who += "@" + host
+	}
+
+	return &backend.LockInfo{
+		ID:        uuid.NewString(),
+		Operation: operation,
+		Who:       who,
+		Version:   version.GetVersion(),
+		Created:   time.Now().UTC(),
+	}
+}
+
+type Client struct {
+	*RemoteStateConfigHTTP
+	*retryablehttp.Client
+}
+
+// NewClient inits HTTP client.
+func NewClient(l log.Logger, config *RemoteStateConfigHTTP) (*Client, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{Transport: transport}
+	httpClient.RetryMax = config.RetryMax
+	httpClient.RetryWaitMin = config.retryWaitMin()
+	httpClient.RetryWaitMax = config.retryWaitMax()
+	httpClient.Logger = nil
+	httpClient.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt > 0 {
+			l.Debugf("Retrying HTTP remote state request %s %s, attempt %d", req.Method, req.URL.Redacted(), attempt)
+		}
+	}
+
+	return &Client{
+		RemoteStateConfigHTTP: config,
+		Client:                httpClient,
+	}, nil
+}
+
+// GetState returns the state stored at the configured address. Returns false if there is no state yet.
+func (client *Client) GetState(ctx context.Context) ([]byte, bool, error) {
+	resp, err := client.do(ctx, http.MethodGet, client.Address, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent, http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, errors.New(UnexpectedStatusError{Method: http.MethodGet, Address: client.Address, StatusCode: resp.StatusCode})
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, errors.Errorf("failed to read HTTP remote state %s: %w", client.Address, err)
+	}
+
+	return data, len(data) > 0, nil
+}
+
+// PutState stores the given state at the configured address using the configured update method. If lockID is not
+// empty, it is passed in the `ID` query parameter, the same way OpenTofu/Terraform do it.
+func (client *Client) PutState(ctx context.Context, data []byte, lockID string) error {
+	address := client.Address
+
+	if lockID != "" {
+		u, err := url.Parse(address)
+		if err != nil {
+			return errors.New(err)
+		}
+
+		query := u.Query()
+		query.Set("ID", lockID)
+		u.RawQuery = query.Encode()
+		address = u.String()
+	}
+
+	sum := md5.Sum(data) //nolint:gosec
+
+	resp, err := client.do(ctx, client.UpdateMethod, address, data, func(req *retryablehttp.Request) {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	})
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
+	default:
+		return errors.New(UnexpectedStatusError{Method: client.UpdateMethod, Address: client.Address, StatusCode: resp.StatusCode})
+	}
+}
+
+// DeleteState deletes the state stored at the configured address.
+func (client *Client) DeleteState(ctx context.Context) error {
+	resp, err := client.do(ctx, http.MethodDelete, client.Address, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errors.New(UnexpectedStatusError{Method: http.MethodDelete, Address: client.Address, StatusCode: resp.StatusCode})
+	}
+}
+
+// Lock locks the state using the configured lock address and method. It does nothing if locking is not configured.
+func (client *Client) Lock(ctx context.Context, l log.Logger, info *backend.LockInfo) error {
+	if !client.IsLockingEnabled() {
+		return nil
+	}
+
+	l.Debugf("Locking HTTP remote state %s with lock ID %s", client.Address, info.ID)
+
+	body, err := json.Marshal(info)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	resp, err := client.do(ctx, client.LockMethod, client.LockAddress, body, func(req *retryablehttp.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusLocked, http.StatusConflict:
+		existing := &backend.LockInfo{}
+
+		if data, err := io.ReadAll(resp.Body); err != nil || json.Unmarshal(data, existing) != nil {
+			existing = nil
+		}
+
+		return errors.New(StateLockedError{Address: client.Address, Info: existing})
+	default:
+		return errors.New(UnexpectedStatusError{Method: client.LockMethod, Address: client.LockAddress, StatusCode: resp.StatusCode})
+	}
+}
+
+// Unlock unlocks the state using the configured unlock address and method. It does nothing if unlocking is not configured.
+func (client *Client) Unlock(ctx context.Context, l log.Logger, info *backend.LockInfo) error {
+	if !client.IsLockingEnabled() || client.UnlockAddress == "" {
+		return nil
+	}
+
+	l.Debugf("Unlocking HTTP remote state %s with lock ID %s", client.Address, info.ID)
+
+	body, err := json.Marshal(info)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	resp, err := client.do(ctx, client.UnlockMethod, client.UnlockAddress, body, func(req *retryablehttp.Request) {
+		req.Header.Set("Content-Type", "application/json")
+	})
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	default:
+		return errors.New(UnexpectedStatusError{Method: client.UnlockMethod, Address: client.UnlockAddress, StatusCode: resp.StatusCode})
+	}
+}
+
+// IsEndpointAvailable probes the configured address and returns true if the endpoint responds in a way that
+// OpenTofu/Terraform can work with, that is, with existing state or with no state yet.
+func (client *Client) IsEndpointAvailable(ctx context.Context, l log.Logger) bool {
+	if _, _, err := client.GetState(ctx); err != nil {
+		l.Debugf("HTTP remote state endpoint %s is not available: %v", client.Address, err)
+
+		return false
+	}
+
+	return true
+}
+
+// VerifyEndpoint returns a drift if the configured state endpoint is not available.
+func (client *Client) VerifyEndpoint(ctx context.Context, l log.Logger) backend.Drifts {
+	if client.IsEndpointAvailable(ctx, l) {
+		return nil
+	}
+
+	return backend.Drifts{backend.NewDrift("HTTP endpoint "+client.Address, "availability", "available", "unavailable")}
+}
+
+func (client *Client) do(ctx context.Context, method, address string, body []byte, modifiers ...func(req *retryablehttp.Request)) (*http.Response, error) {
+	var reqBody any
+
+	if body != nil {
+		reqBody = body
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, address, reqBody)
+	if err != nil {
+		return nil, errors.Errorf("failed to create HTTP remote state request %s %s: %w", method, address, err)
+	}
+
+	if client.Username != "" {
+		req.SetBasicAuth(client.Username, client.Password)
+	}
+
+	for _, modifier := range modifiers {
+		modifier(req)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Errorf("failed to make HTTP remote state request %s %s: %w", method, address, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		resp.Body.Close() //nolint:errcheck,gosec
+		return nil, errors.Errorf("HTTP remote state endpoint %s requires auth", address)
+	case http.StatusForbidden:
+		resp.Body.Close() //nolint:errcheck,gosec
+		return nil, errors.Errorf("HTTP remote state endpoint %s invalid auth", address)
+	}
+
+	return resp, nil
+}
+
+func (cfg *RemoteStateConfigHTTP) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.SkipCertVerification, //nolint:gosec
+	}
+
+	if cfg.ClientCACertificatePEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.ClientCACertificatePEM)) {
+			return nil, errors.New("failed to parse client_ca_certificate_pem")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertificatePEM != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertificatePEM), []byte(cfg.ClientPrivateKeyPEM))
+		if err != nil {
+			return nil, errors.Errorf("failed to parse client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
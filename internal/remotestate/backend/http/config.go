@@ -0,0 +1,47 @@
+package http
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/mitchellh/mapstructure"
+)
+
+type Config map[string]any
+
+// ParseHTTPConfig parses the given map into an HTTP config. The keys that are not set in the map are read from the
+// same environment variables that OpenTofu/Terraform use.
+func (cfg Config) ParseHTTPConfig() (*RemoteStateConfigHTTP, error) {
+	var (
+		httpConfig = newRemoteStateConfigHTTP()
+		withEnv    = make(map[string]any, len(cfg)+len(envVarDefaults))
+	)
+
+	for key := range envVarDefaults {
+		if val := envDefault(key); val != "" {
+			withEnv[key] = val
+		}
+	}
+
+	for key, val := range cfg {
+		if val != nil {
+			withEnv[key] = val
+		}
+	}
+
+	if err := mapstructure.WeakDecode(withEnv, httpConfig); err != nil {
+		return nil, errors.New(err)
+	}
+
+	httpConfig.applyDefaults()
+
+	return httpConfig, nil
+}
+
+// HTTPConfig parses the given map into an HTTP config and validates this config.
+func (cfg Config) HTTPConfig() (*RemoteStateConfigHTTP, error) {
+	httpCfg, err := cfg.ParseHTTPConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return httpCfg, httpCfg.Validate()
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+)
+
+var ErrIncompleteClientCertificate = errors.New("client_certificate_pem and client_private_key_pem must be set together")
+
+type MissingRequiredHTTPRemoteStateConfig string
+
+func (configName MissingRequiredHTTPRemoteStateConfig) Error() string {
+	return "Missing required HTTP remote state configuration " + string(configName)
+}
+
+// UnexpectedStatusError is the error that is returned when the HTTP backend responds with an unexpected status code.
+type UnexpectedStatusError struct {
+	Method     string
+	Address    string
+	StatusCode int
+}
+
+// Error implements `error` interface.
+func (err UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("HTTP remote state endpoint %s %s responded with unexpected status code %d", err.Method, err.Address, err.StatusCode)
+}
+
+// StateLockedError is the error that is returned when the state is already locked by another process.
+type StateLockedError struct {
+	Address string
+	Info    *backend.LockInfo
+}
+
+// Error implements `error` interface.
+func (err StateLockedError) Error() string {
+	if err.Info == nil || err.Info.ID == "" {
+		return fmt.Sprintf("HTTP remote state %s is already locked", err.Address)
+	}
+
+	return fmt.Sprintf("HTTP remote state %s is already locked by %s (lock ID %s, operation %q, created %s)", err.Address, err.Info.Who, err.Info.ID, err.Info.Operation, err.Info.Created)
+}
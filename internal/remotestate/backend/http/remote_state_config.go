@@ -0,0 +1,120 @@
+package http
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+const (
+	defaultUpdateMethod = http.MethodPost
+	defaultLockMethod   = "LOCK"
+	defaultUnlockMethod = "UNLOCK"
+
+	defaultRetryMax     = 2
+	defaultRetryWaitMin = 1
+	defaultRetryWaitMax = 30
+)
+
+// envVarDefaults maps the config keys to the environment variables that OpenTofu/Terraform read when the key is not set.
+var envVarDefaults = map[string]string{
+	"address":                   "TF_HTTP_ADDRESS",
+	"update_method":             "TF_HTTP_UPDATE_METHOD",
+	"lock_address":              "TF_HTTP_LOCK_ADDRESS",
+	"lock_method":               "TF_HTTP_LOCK_METHOD",
+	"unlock_address":            "TF_HTTP_UNLOCK_ADDRESS",
+	"unlock_method":             "TF_HTTP_UNLOCK_METHOD",
+	"username":                  "TF_HTTP_USERNAME",
+	"password":                  "TF_HTTP_PASSWORD",
+	"client_ca_certificate_pem": "TF_HTTP_CLIENT_CA_CERTIFICATE_PEM",
+	"client_certificate_pem":    "TF_HTTP_CLIENT_CERTIFICATE_PEM",
+	"client_private_key_pem":    "TF_HTTP_CLIENT_PRIVATE_KEY_PEM",
+}
+
+// RemoteStateConfigHTTP is a representation of the configuration
+// options available for HTTP remote state.
+type RemoteStateConfigHTTP struct {
+	Address                string `mapstructure:"address"`
+	UpdateMethod           string `mapstructure:"update_method"`
+	LockAddress            string `mapstructure:"lock_address"`
+	LockMethod             string `mapstructure:"lock_method"`
+	UnlockAddress          string `mapstructure:"unlock_address"`
+	UnlockMethod           string `mapstructure:"unlock_method"`
+	Username               string `mapstructure:"username"`
+	Password               string `mapstructure:"password"`
+	ClientCACertificatePEM string `mapstructure:"client_ca_certificate_pem"`
+	ClientCertificatePEM   string `mapstructure:"client_certificate_pem"`
+	ClientPrivateKeyPEM    string `mapstructure:"client_private_key_pem"`
+	RetryMax               int    `mapstructure:"retry_max"`
+	RetryWaitMin           int    `mapstructure:"retry_wait_min"`
+	RetryWaitMax           int    `mapstructure:"retry_wait_max"`
+	SkipCertVerification   bool   `mapstructure:"skip_cert_verification"`
+}
+
+// Validate validates the configuration for HTTP remote state.
+func (cfg *RemoteStateConfigHTTP) Validate() error {
+	if cfg.Address == "" {
+		return errors.New(MissingRequiredHTTPRemoteStateConfig("address"))
+	}
+
+	if (cfg.ClientCertificatePEM == "") != (cfg.ClientPrivateKeyPEM == "") {
+		return errors.New(ErrIncompleteClientCertificate)
+	}
+
+	return nil
+}
+
+// IsLockingEnabled returns true if the backend is configured with a lock address.
+func (cfg *RemoteStateConfigHTTP) IsLockingEnabled() bool {
+	return cfg.LockAddress != ""
+}
+
+// CacheKey returns a unique key for the given HTTP config that can be used to cache the initialization.
+func (cfg *RemoteStateConfigHTTP) CacheKey() string {
+	return cfg.Address
+}
+
+// newRemoteStateConfigHTTP returns a config with the retry settings that OpenTofu/Terraform use by default. Decoding
+// the config keys into it overwrites only the configured ones, so an explicit zero, e.g. `retry_max = 0`, is kept.
+func newRemoteStateConfigHTTP() *RemoteStateConfigHTTP {
+	return &RemoteStateConfigHTTP{
+		RetryMax:     defaultRetryMax,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+	}
+}
+
+// applyDefaults sets the methods that OpenTofu/Terraform would use for the keys that are not configured or empty.
+func (cfg *RemoteStateConfigHTTP) applyDefaults() {
+	if cfg.UpdateMethod == "" {
+		cfg.UpdateMethod = defaultUpdateMethod
+	}
+
+	if cfg.LockMethod == "" {
+		cfg.LockMethod = defaultLockMethod
+	}
+
+	if cfg.UnlockMethod == "" {
+		cfg.UnlockMethod = defaultUnlockMethod
+	}
+
+}
+
+func (cfg *RemoteStateConfigHTTP) retryWaitMin() time.Duration {
+	return time.Duration(cfg.RetryWaitMin) * time.Second
+}
+
+func (cfg *RemoteStateConfigHTTP) retryWaitMax() time.Duration {
+	return time.Duration(cfg.RetryWaitMax) * time.Second
+}
+
+// envDefault returns the value of the environment variable that OpenTofu/Terraform read for the given config key.
+func envDefault(key string) string {
+	if envVar, ok := envVarDefaults[key]; ok {
+		return os.Getenv(envVar)
+	}
+
+	return ""
+}
@@ -0,0 +1,41 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+)
+
+// LockInfo is the lock payload that OpenTofu/Terraform store when they lock the state.
+type LockInfo struct {
+	Created   time.Time `json:"Created"`
+	ID        string    `json:"ID"`
+	Operation string    `json:"Operation"`
+	Info      string    `json:"Info"`
+	Who       string    `json:"Who"`
+	Version   string    `json:"Version"`
+	Path      string    `json:"Path"`
+}
+
+// ParseLockInfo parses the given lock payload.
+func ParseLockInfo(data []byte) (*LockInfo, error) {
+	info := &LockInfo{}
+
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, errors.Errorf("failed to parse state lock info: %w", err)
+	}
+
+	return info, nil
+}
+
+// Age returns the time elapsed since the lock was created.
+func (info *LockInfo) Age() time.Duration {
+	return time.Since(info.Created)
+}
+
+// String implements `fmt.Stringer` interface.
+func (info *LockInfo) String() string {
+	return fmt.Sprintf("ID=%s Operation=%s Who=%s Created=%s", info.ID, info.Operation, info.Who, info.Created)
+}
@@ -0,0 +1,28 @@
+package backend_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLockInfo(t *testing.T) {
+	t.Parallel()
+
+	created := time.Now().Add(-time.Hour).UTC()
+
+	info, err := backend.ParseLockInfo([]byte(`{"ID":"8d2c0c5e","Operation":"OperationTypeApply","Who":"jane@host","Version":"1.9.0","Created":"` + created.Format(time.RFC3339Nano) + `","Path":"bucket/unit/terraform.tfstate"}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "8d2c0c5e", info.ID)
+	assert.Equal(t, "OperationTypeApply", info.Operation)
+	assert.Equal(t, "jane@host", info.Who)
+	assert.Equal(t, "bucket/unit/terraform.tfstate", info.Path)
+	assert.InDelta(t, time.Hour.Seconds(), info.Age().Seconds(), 60)
+
+	_, err = backend.ParseLockInfo([]byte("not json"))
+	require.Error(t, err)
+}
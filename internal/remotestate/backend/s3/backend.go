@@ -176,6 +176,15 @@ func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendCon
 		return err
 	}
 
+	if srcExtS3Cfg.RemoteStateConfigS3.UseLockfile {
+		srcLockfileKey := srcExtS3Cfg.RemoteStateConfigS3.GetLockfileKey()
+		dstLockfileKey := dstExtS3Cfg.RemoteStateConfigS3.GetLockfileKey()
+
+		if err = client.MoveS3ObjectIfNecessary(ctx, l, srcBucketName, srcLockfileKey, dstBucketName, dstLockfileKey); err != nil {
+			return err
+		}
+	}
+
 	if dstTableName != "" {
 		if err := client.CreateTableItemIfNecessary(ctx, l, dstTableName, dstTableKey); err != nil {
 			return err
@@ -221,10 +230,17 @@ func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig
 	}
 
 	prompt := fmt.Sprintf("S3 bucket %s key %s will be deleted. Do you want to continue?", bucketName, bucketKey)
-	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil {
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts); err != nil || !yes {
 		return err
-	} else if yes {
-		return client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, bucketKey)
+	}
+
+	if err := client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, bucketKey); err != nil {
+		return err
+	}
+
+	// Remove the stale S3-native lock file, otherwise it would block the next run that uses the same key.
+	if extS3Cfg.RemoteStateConfigS3.UseLockfile {
+		return client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, extS3Cfg.RemoteStateConfigS3.GetLockfileKey())
 	}
 
 	return nil
@@ -268,6 +284,185 @@ func (backend *Backend) DeleteBucket(ctx context.Context, l log.Logger, backendC
 	return nil
 }
 
+// Verify compares the S3 bucket and DynamoDB table specified in the given config with their actual settings
+// and returns every mismatch.
+func (backend *Backend) Verify(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (backend.Drifts, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts, err := client.VerifyS3Bucket(ctx, l, extS3Cfg.RemoteStateConfigS3.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if tableName := extS3Cfg.RemoteStateConfigS3.GetLockTableName(); tableName != "" {
+		tableDrifts, err := client.VerifyLockTable(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, tableDrifts...)
+	}
+
+	return drifts, nil
+}
+
+// GetLock returns the state lock held in the DynamoDB table, or in the S3-native lock file when `use_lockfile` is enabled.
+func (backend *Backend) GetLock(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (*backend.LockInfo, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		bucketName = extS3Cfg.RemoteStateConfigS3.Bucket
+		bucketKey  = extS3Cfg.RemoteStateConfigS3.Key
+		tableName  = extS3Cfg.RemoteStateConfigS3.GetLockTableName()
+	)
+
+	if extS3Cfg.RemoteStateConfigS3.UseLockfile {
+		info, err := client.GetS3ObjectLockInfo(ctx, bucketName, extS3Cfg.RemoteStateConfigS3.GetLockfileKey())
+		if err != nil || info != nil {
+			return info, err
+		}
+	}
+
+	if tableName == "" {
+		return nil, nil
+	}
+
+	return client.GetTableItemLockInfo(ctx, tableName, path.Join(bucketName, bucketKey))
+}
+
+// ReleaseLock removes the state lock from the DynamoDB table and the S3-native lock file, whichever are configured.
+func (backend *Backend) ReleaseLock(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) error {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketName = extS3Cfg.RemoteStateConfigS3.Bucket
+		bucketKey  = extS3Cfg.RemoteStateConfigS3.Key
+		tableName  = extS3Cfg.RemoteStateConfigS3.GetLockTableName()
+	)
+
+	if extS3Cfg.RemoteStateConfigS3.UseLockfile {
+		if err := client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, extS3Cfg.RemoteStateConfigS3.GetLockfileKey()); err != nil {
+			return err
+		}
+	}
+
+	if tableName == "" {
+		return nil
+	}
+
+	return client.DeleteTableItemIfNecessary(ctx, l, tableName, path.Join(bucketName, bucketKey))
+}
+
+// GetSnapshotStore returns the store that keeps the state snapshots in the S3 bucket under `<prefix>/<key>/`.
+func (backend *Backend) GetSnapshotStore(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, prefix string) (backend.SnapshotStore, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshotStore(client, extS3Cfg.RemoteStateConfigS3.Bucket, path.Join(prefix, extS3Cfg.RemoteStateConfigS3.Key)), nil
+}
+
+// GetStateLocation returns the place in the S3 bucket where the states of the unit are stored, including the states
+// of non-default workspaces under `workspace_key_prefix`.
+func (backend *Backend) GetStateLocation(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions) (*backend.StateLocation, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceKeyPrefix := defaultWorkspaceKeyPrefix
+
+	if prefix, ok := backendConfig["workspace_key_prefix"].(string); ok && prefix != "" {
+		workspaceKeyPrefix = prefix
+	}
+
+	return newStateLocation(extS3Cfg.RemoteStateConfigS3.Bucket, extS3Cfg.RemoteStateConfigS3.Key, workspaceKeyPrefix), nil
+}
+
+// ListStateObjects returns all the state objects with the given key prefix in the S3 bucket.
+func (backend *Backend) ListStateObjects(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, prefix string) ([]*backend.StateObject, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ListS3StateObjects(ctx, extS3Cfg.RemoteStateConfigS3.Bucket, prefix)
+}
+
+// DeleteStateObject deletes the state object with the given key from the S3 bucket, along with its digest item
+// in the DynamoDB table.
+func (backend *Backend) DeleteStateObject(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *options.TerragruntOptions, key string) error {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(l, extS3Cfg, opts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketName = extS3Cfg.RemoteStateConfigS3.Bucket
+		tableName  = extS3Cfg.RemoteStateConfigS3.GetLockTableName()
+	)
+
+	if err := client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, key); err != nil {
+		return err
+	}
+
+	if tableName == "" {
+		return nil
+	}
+
+	return client.DeleteTableItemIfNecessary(ctx, l, tableName, path.Join(bucketName, key+stateIDSuffix))
+}
+
 func (backend *Backend) GetTFInitArgs(config backend.Config) map[string]any {
 	return Config(config).GetTFInitArgs()
 }
+
+func newStateLocation(bucket, key, workspaceKeyPrefix string) *backend.StateLocation {
+	return &backend.StateLocation{
+		Bucket: bucket,
+		KeyPatterns: []string{
+			backend.EscapeKeyPattern(key),
+			path.Join(backend.EscapeKeyPattern(workspaceKeyPrefix), "*", backend.EscapeKeyPattern(key)),
+		},
+	}
+}
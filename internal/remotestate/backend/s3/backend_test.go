@@ -5,8 +5,11 @@ import (
 
 	backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
 	s3backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBackend_GetTFInitArgs(t *testing.T) {
@@ -122,6 +125,22 @@ func TestBackend_GetTFInitArgs(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"use-lockfile",
+			backend.Config{
+				"bucket":       "foo",
+				"key":          "baz",
+				"region":       "quux",
+				"use_lockfile": true,
+			},
+			map[string]any{
+				"bucket":       "foo",
+				"key":          "baz",
+				"region":       "quux",
+				"use_lockfile": true,
+			},
+			true,
+		},
 		{
 			"assume-role",
 			backend.Config{
@@ -155,3 +174,38 @@ func TestBackend_GetTFInitArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestBackend_GetStateLocation(t *testing.T) {
+	t.Parallel()
+
+	remoteBackend := s3backend.NewBackend()
+
+	testCases := []struct {
+		name     string
+		config   backend.Config
+		expected []string
+	}{
+		{
+			"default-workspace-key-prefix",
+			backend.Config{"bucket": "foo", "key": "app/terraform.tfstate", "region": "quux"},
+			[]string{"app/terraform.tfstate", "env:/*/app/terraform.tfstate"},
+		},
+		{
+			"custom-workspace-key-prefix",
+			backend.Config{"bucket": "foo", "key": "app/terraform.tfstate", "region": "quux", "workspace_key_prefix": "workspaces"},
+			[]string{"app/terraform.tfstate", "workspaces/*/app/terraform.tfstate"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			loc, err := remoteBackend.GetStateLocation(t.Context(), logger.CreateLogger(), tc.config, options.NewTerragruntOptions())
+			require.NoError(t, err)
+
+			assert.Equal(t, "foo", loc.Bucket)
+			assert.Equal(t, tc.expected, loc.KeyPatterns)
+		})
+	}
+}
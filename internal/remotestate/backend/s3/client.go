@@ -3,9 +3,11 @@ package s3
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,9 +49,21 @@ const (
 	// OpenTofu/Terraform requires the DynamoDB table to have a primary key with this name
 	AttrLockID = "LockID"
 
+	// attrLockInfo is the name of the lock table attribute where OpenTofu/Terraform store the state lock info.
+	attrLockInfo = "Info"
+
 	// stateIDSuffix is last saved serial in tablestore with this suffix for consistency checks.
 	stateIDSuffix = "-md5"
 
+	// stateFileSuffix is the suffix of the state objects.
+	stateFileSuffix = ".tfstate"
+
+	// defaultWorkspaceKeyPrefix is the prefix of the state keys of non-default workspaces, unless `workspace_key_prefix` is set.
+	defaultWorkspaceKeyPrefix = "env:"
+
+	// lockfileSuffix is the suffix of the S3-native lock file that is created next to the state file when `use_lockfile` is enabled.
+	lockfileSuffix = ".tflock"
+
 	// MaxRetriesWaitingForTableToBeActive is the maximum number of times we
 	// will retry waiting for a table to be active.
 	//
@@ -409,6 +423,75 @@ func (client *Client) checkIfS3BucketNeedsUpdate(ctx context.Context, l log.Logg
 	return false, toUpdate, nil
 }
 
+// VerifyS3Bucket compares the settings of the given S3 bucket with the config and returns every mismatch.
+// Settings skipped in the config are not checked.
+func (client *Client) VerifyS3Bucket(ctx context.Context, l log.Logger, bucketName string) (backend.Drifts, error) {
+	resource := "S3 bucket " + bucketName
+
+	if exists, err := client.DoesS3BucketExist(ctx, bucketName); err != nil {
+		return nil, err
+	} else if !exists {
+		return backend.Drifts{backend.NewMissingResourceDrift(resource)}, nil
+	}
+
+	_, toUpdate, err := client.checkIfS3BucketNeedsUpdate(ctx, l, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts backend.Drifts
+
+	if toUpdate.Versioning {
+		drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "versioning"))
+	}
+
+	if toUpdate.SSEEncryption {
+		algorithm, err := client.getS3BucketSSEAlgorithm(l, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if algorithm == "" {
+			algorithm = backend.DriftValueDisabled
+		}
+
+		drifts = append(drifts, backend.NewDrift(resource, "server-side encryption", client.FetchEncryptionAlgorithm(), algorithm))
+	}
+
+	if toUpdate.RootAccess {
+		drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "root access policy"))
+	}
+
+	if toUpdate.EnforcedTLS {
+		drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "enforced TLS policy"))
+	}
+
+	if toUpdate.AccessLogging {
+		output, err := client.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{Bucket: aws.String(bucketName)})
+		if err != nil {
+			return nil, errors.Errorf("error checking if Access Logging is enabled for AWS S3 bucket %s: %w", bucketName, err)
+		}
+
+		expected := client.CreateS3LoggingInput()
+
+		drifts = append(drifts, backend.NewDrift(resource, "access logging", formatS3LoggingTarget(expected.BucketLoggingStatus.LoggingEnabled), formatS3LoggingTarget(output.LoggingEnabled)))
+	}
+
+	if toUpdate.PublicAccess {
+		drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "public access blocking"))
+	}
+
+	return drifts, nil
+}
+
+func formatS3LoggingTarget(logging *s3.LoggingEnabled) string {
+	if logging == nil {
+		return backend.DriftValueDisabled
+	}
+
+	return "s3://" + path.Join(aws.StringValue(logging.TargetBucket), aws.StringValue(logging.TargetPrefix))
+}
+
 // CheckIfVersioningEnabled checks if versioning is enabled for the S3 bucket specified in the given config and warn the user if it is not
 func (client *Client) CheckIfVersioningEnabled(ctx context.Context, l log.Logger, bucketName string) (bool, error) {
 	if exists, err := client.DoesS3BucketExist(ctx, bucketName); err != nil {
@@ -1002,6 +1085,16 @@ func (client *Client) EnableSSEForS3BucketWide(l log.Logger, bucketName string,
 }
 
 func (client *Client) checkIfSSEForS3MatchesConfig(l log.Logger, bucketName string) (bool, error) {
+	algorithm, err := client.getS3BucketSSEAlgorithm(l, bucketName)
+	if err != nil {
+		return false, err
+	}
+
+	return algorithm == client.FetchEncryptionAlgorithm(), nil
+}
+
+// getS3BucketSSEAlgorithm returns the default Server-Side Encryption algorithm of the given bucket, or an empty string if there is none.
+func (client *Client) getS3BucketSSEAlgorithm(l log.Logger, bucketName string) (string, error) {
 	l.Debugf("Checking if SSE is enabled for AWS S3 bucket %s", bucketName)
 
 	input := &s3.GetBucketEncryptionInput{Bucket: aws.String(bucketName)}
@@ -1010,24 +1103,20 @@ func (client *Client) checkIfSSEForS3MatchesConfig(l log.Logger, bucketName stri
 	if err != nil {
 		l.Debugf("Error checking if SSE is enabled for AWS S3 bucket %s: %s", bucketName, err.Error())
 
-		return false, errors.Errorf("error checking if SSE is enabled for AWS S3 bucket %s: %w", bucketName, err)
+		return "", errors.Errorf("error checking if SSE is enabled for AWS S3 bucket %s: %w", bucketName, err)
 	}
 
 	if output.ServerSideEncryptionConfiguration == nil {
-		return false, nil
+		return "", nil
 	}
 
 	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
 		if rule.ApplyServerSideEncryptionByDefault != nil && rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm != nil {
-			if *rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm == client.FetchEncryptionAlgorithm() {
-				return true, nil
-			}
-
-			return false, nil
+			return *rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm, nil
 		}
 	}
 
-	return false, nil
+	return "", nil
 }
 
 // EnableAccessLoggingForS3BucketWide enables bucket-wide Access Logging for the AWS S3 bucket specified in the given config.
@@ -1518,6 +1607,35 @@ func (client *Client) DoesLockTableExist(ctx context.Context, tableName string)
 	return true, nil
 }
 
+// VerifyLockTable compares the settings of the given DynamoDB lock table with the config and returns every mismatch.
+func (client *Client) VerifyLockTable(ctx context.Context, tableName string) (backend.Drifts, error) {
+	resource := "DynamoDB table " + tableName
+
+	output, err := client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(tableName)})
+	if err != nil {
+		var awsErr awserr.Error
+		if ok := errors.As(err, &awsErr); ok && awsErr.Code() == "ResourceNotFoundException" {
+			return backend.Drifts{backend.NewMissingResourceDrift(resource)}, nil
+		}
+
+		return nil, errors.New(err)
+	}
+
+	var drifts backend.Drifts
+
+	if status := aws.StringValue(output.Table.TableStatus); status != dynamodb.TableStatusActive {
+		drifts = append(drifts, backend.NewDrift(resource, "status", dynamodb.TableStatusActive, status))
+	}
+
+	if client.EnableLockTableSSEncryption {
+		if output.Table.SSEDescription == nil || aws.StringValue(output.Table.SSEDescription.Status) != dynamodb.SSEStatusEnabled {
+			drifts = append(drifts, backend.NewDisabledSettingDrift(resource, "server-side encryption"))
+		}
+	}
+
+	return drifts, nil
+}
+
 // LockTableCheckSSEncryptionIsOn returns true if the lock table's SSEncryption is turned on
 func (client *Client) LockTableCheckSSEncryptionIsOn(ctx context.Context, tableName string) (bool, error) {
 	input := &dynamodb.DescribeTableInput{
@@ -1808,6 +1926,97 @@ func (client *Client) DoesTableItemExistWithLogging(ctx context.Context, l log.L
 	return false, nil
 }
 
+// GetTableItemLockInfo returns the state lock stored in the `Info` attribute of the given DynamoDB table key,
+// or nil if the table or the key does not exist.
+func (client *Client) GetTableItemLockInfo(ctx context.Context, tableName, key string) (*backend.LockInfo, error) {
+	if exists, err := client.DoesLockTableExist(ctx, tableName); err != nil || !exists {
+		return nil, err
+	}
+
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(tableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			AttrLockID: {
+				S: aws.String(key),
+			},
+		},
+		ConsistentRead: aws.Bool(true),
+	}
+
+	res, err := client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errors.Errorf("failed to get item by key %s of table %s: %w", key, tableName, err)
+	}
+
+	attr, ok := res.Item[attrLockInfo]
+	if !ok || attr.S == nil {
+		return nil, nil
+	}
+
+	return backend.ParseLockInfo([]byte(aws.StringValue(attr.S)))
+}
+
+// GetS3ObjectLockInfo returns the state lock stored in the given S3 object, or nil if the bucket or the object does not exist.
+func (client *Client) GetS3ObjectLockInfo(ctx context.Context, bucketName, key string) (*backend.LockInfo, error) {
+	if exists, err := client.DoesS3BucketExist(ctx, bucketName); err != nil || !exists {
+		return nil, err
+	}
+
+	if exists, err := client.DoesS3ObjectExist(ctx, bucketName, key); err != nil || !exists {
+		return nil, err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}
+
+	res, err := client.GetObjectWithContext(ctx, input)
+	if err != nil {
+		return nil, errors.Errorf("failed to get S3 object %s in bucket %s: %w", key, bucketName, err)
+	}
+
+	defer res.Body.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Errorf("failed to read S3 object %s in bucket %s: %w", key, bucketName, err)
+	}
+
+	return backend.ParseLockInfo(data)
+}
+
+// ListS3StateObjects returns all the state objects with the given key prefix in the given bucket.
+func (client *Client) ListS3StateObjects(ctx context.Context, bucketName, prefix string) ([]*backend.StateObject, error) {
+	var (
+		objects []*backend.StateObject
+		input   = &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucketName),
+			Prefix: aws.String(prefix),
+		}
+	)
+
+	err := client.ListObjectsV2PagesWithContext(ctx, input, func(res *s3.ListObjectsV2Output, _ bool) bool {
+		for _, item := range res.Contents {
+			if key := aws.StringValue(item.Key); strings.HasSuffix(key, stateFileSuffix) {
+				objects = append(objects, &backend.StateObject{
+					Bucket:       bucketName,
+					Key:          key,
+					Size:         aws.Int64Value(item.Size),
+					LastModified: aws.TimeValue(item.LastModified),
+				})
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to list state objects in S3 bucket %s: %w", bucketName, err)
+	}
+
+	return objects, nil
+}
+
 // CopyS3BucketObject copies the S3 object at the specified `srcBucketName` and `srcKey` to the `dstBucketName` and `dstKey`.
 func (client *Client) CopyS3BucketObject(ctx context.Context, l log.Logger, srcBucketName, srcKey, dstBucketName, dstKey string) error {
 	l.Debugf("Copying S3 bucket object from %s to %s", path.Join(srcBucketName, srcKey), path.Join(dstBucketName, dstKey))
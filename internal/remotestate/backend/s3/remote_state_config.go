@@ -189,6 +189,7 @@ type RemoteStateConfigS3 struct {
 	AssumeRole       RemoteStateConfigS3AssumeRole `mapstructure:"assume_role"`
 	Encrypt          bool                          `mapstructure:"encrypt"`
 	S3ForcePathStyle bool                          `mapstructure:"force_path_style"`
+	UseLockfile      bool                          `mapstructure:"use_lockfile"`
 }
 
 // CacheKey returns a unique key for the given S3 config that can be used to cache the initialization
@@ -215,6 +216,12 @@ func (cfg *RemoteStateConfigS3) GetLockTableName() string {
 	return cfg.LockTable
 }
 
+// GetLockfileKey returns the key of the S3-native lock file that OpenTofu/Terraform create next to the state file
+// when `use_lockfile` is enabled.
+func (cfg *RemoteStateConfigS3) GetLockfileKey() string {
+	return cfg.Key + lockfileSuffix
+}
+
 // GetSessionRoleArn returns the role defined in the AssumeRole struct
 // or fallback to the top level argument deprecated in Terraform 1.6
 func (cfg *RemoteStateConfigS3) GetSessionRoleArn() string {
@@ -337,3 +337,61 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_UseLockfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                  string
+		config                s3backend.Config
+		expectedLockfileKey   string
+		expectedLockTableName string
+		expectedUseLockfile   bool
+	}{
+		{
+			name: "dynamodb-table",
+			config: s3backend.Config{
+				"bucket":         "foo",
+				"key":            "unit/terraform.tfstate",
+				"dynamodb_table": "locks",
+			},
+			expectedLockfileKey:   "unit/terraform.tfstate.tflock",
+			expectedLockTableName: "locks",
+		},
+		{
+			name: "use-lockfile",
+			config: s3backend.Config{
+				"bucket":       "foo",
+				"key":          "unit/terraform.tfstate",
+				"use_lockfile": true,
+			},
+			expectedLockfileKey: "unit/terraform.tfstate.tflock",
+			expectedUseLockfile: true,
+		},
+		{
+			name: "use-lockfile-with-dynamodb-table",
+			config: s3backend.Config{
+				"bucket":         "foo",
+				"key":            "unit/terraform.tfstate",
+				"dynamodb_table": "locks",
+				"use_lockfile":   true,
+			},
+			expectedLockfileKey:   "unit/terraform.tfstate.tflock",
+			expectedLockTableName: "locks",
+			expectedUseLockfile:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			extCfg, err := tc.config.ParseExtendedS3Config()
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedUseLockfile, extCfg.RemoteStateConfigS3.UseLockfile)
+			assert.Equal(t, tc.expectedLockfileKey, extCfg.RemoteStateConfigS3.GetLockfileKey())
+			assert.Equal(t, tc.expectedLockTableName, extCfg.RemoteStateConfigS3.GetLockTableName())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+var _ backend.SnapshotObjects = new(snapshotObjects)
+
+// snapshotObjects reads and writes the objects of the state snapshots in an S3 bucket.
+type snapshotObjects struct {
+	client *Client
+	bucket string
+}
+
+// NewSnapshotStore returns a new snapshot store that keeps the snapshots as objects under the given prefix of the bucket.
+func NewSnapshotStore(client *Client, bucket, prefix string) *backend.BucketSnapshotStore {
+	return backend.NewBucketSnapshotStore(&snapshotObjects{client: client, bucket: bucket}, prefix)
+}
+
+// PutObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) PutObject(ctx context.Context, l log.Logger, key string, data []byte) error {
+	l.Debugf("Saving state snapshot to S3 bucket %s key %s", objects.bucket, key)
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(objects.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	}
+
+	if objects.client.RemoteStateConfigS3.Encrypt {
+		input.ServerSideEncryption = aws.String(objects.client.FetchEncryptionAlgorithm())
+
+		if objects.client.BucketSSEKMSKeyID != "" {
+			input.SSEKMSKeyId = aws.String(objects.client.BucketSSEKMSKeyID)
+		}
+	}
+
+	if _, err := objects.client.PutObjectWithContext(ctx, input); err != nil {
+		return errors.Errorf("failed to put S3 bucket %s key %s: %w", objects.bucket, key, err)
+	}
+
+	return nil
+}
+
+// ListObjects implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) ListObjects(ctx context.Context, l log.Logger, prefix string) ([]string, error) {
+	var (
+		keys  []string
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(objects.bucket),
+			Prefix: aws.String(prefix),
+		}
+	)
+
+	err := objects.client.ListObjectsV2PagesWithContext(ctx, input, func(res *s3.ListObjectsV2Output, _ bool) bool {
+		for _, item := range res.Contents {
+			keys = append(keys, aws.StringValue(item.Key))
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to list S3 bucket %s prefix %s: %w", objects.bucket, prefix, err)
+	}
+
+	return keys, nil
+}
+
+// GetObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) GetObject(ctx context.Context, l log.Logger, key string) ([]byte, error) {
+	res, err := objects.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(objects.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to get S3 bucket %s key %s: %w", objects.bucket, key, err)
+	}
+
+	defer res.Body.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Errorf("failed to get S3 bucket %s key %s: %w", objects.bucket, key, err)
+	}
+
+	return data, nil
+}
+
+// DeleteObject implements `backend.SnapshotObjects` interface.
+func (objects *snapshotObjects) DeleteObject(ctx context.Context, l log.Logger, key string) error {
+	return objects.client.DeleteS3BucketObject(ctx, l, objects.bucket, key, nil)
+}
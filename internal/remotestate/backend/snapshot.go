@@ -0,0 +1,141 @@
+package backend
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// SnapshotFileExt is the extension of the names of the state snapshots.
+	SnapshotFileExt = ".tfstate"
+
+	// SnapshotNameTimeFormat is the format of the time at the start of the names of the state snapshots. It sorts
+	// lexically in chronological order, so the snapshot names can be sorted as strings.
+	SnapshotNameTimeFormat = "20060102T150405.000Z"
+)
+
+// IsSnapshotName returns true if the given object or file name is the name of a state snapshot, made of the time it
+// was taken and the command it was taken before, such as `20250304T050607.890Z-apply.tfstate`.
+func IsSnapshotName(name string) bool {
+	base, ok := strings.CutSuffix(name, SnapshotFileExt)
+	if !ok {
+		return false
+	}
+
+	timestamp, command, ok := strings.Cut(base, "-")
+	if !ok || command == "" {
+		return false
+	}
+
+	_, err := time.Parse(SnapshotNameTimeFormat, timestamp)
+
+	return err == nil
+}
+
+// SnapshotStore stores the state snapshots of a single unit.
+type SnapshotStore interface {
+	// Save stores the given state data as the snapshot with the given name.
+	Save(ctx context.Context, l log.Logger, name string, data []byte) error
+
+	// List returns the names of all stored snapshots.
+	List(ctx context.Context, l log.Logger) ([]string, error)
+
+	// Read returns the state data of the snapshot with the given name.
+	Read(ctx context.Context, l log.Logger, name string) ([]byte, error)
+
+	// Delete deletes the snapshot with the given name.
+	Delete(ctx context.Context, l log.Logger, name string) error
+}
+
+// SnapshotObjects reads and writes the objects of the bucket in which a `BucketSnapshotStore` keeps the snapshots.
+// Backends only implement these storage calls, the naming and listing of the snapshots are shared.
+type SnapshotObjects interface {
+	// PutObject stores the given data as the object with the given key.
+	PutObject(ctx context.Context, l log.Logger, key string, data []byte) error
+
+	// ListObjects returns the keys of all objects whose key starts with the given prefix.
+	ListObjects(ctx context.Context, l log.Logger, prefix string) ([]string, error)
+
+	// GetObject returns the data of the object with the given key.
+	GetObject(ctx context.Context, l log.Logger, key string) ([]byte, error)
+
+	// DeleteObject deletes the object with the given key.
+	DeleteObject(ctx context.Context, l log.Logger, key string) error
+}
+
+var _ SnapshotStore = new(BucketSnapshotStore)
+
+// BucketSnapshotStore stores the state snapshots of a unit as objects directly under a prefix of a bucket.
+type BucketSnapshotStore struct {
+	objects SnapshotObjects
+	prefix  string
+}
+
+// NewBucketSnapshotStore returns a new snapshot store that keeps the snapshots under the given prefix of a bucket.
+func NewBucketSnapshotStore(objects SnapshotObjects, prefix string) *BucketSnapshotStore {
+	return &BucketSnapshotStore{
+		objects: objects,
+		prefix:  strings.TrimSuffix(prefix, "/"),
+	}
+}
+
+// Save implements `SnapshotStore` interface.
+func (store *BucketSnapshotStore) Save(ctx context.Context, l log.Logger, name string, data []byte) error {
+	if err := store.objects.PutObject(ctx, l, store.key(name), data); err != nil {
+		return errors.Errorf("failed to save state snapshot %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// List implements `SnapshotStore` interface. The objects in sub-directories of the prefix are not snapshots of the
+// unit, and are skipped, as are the objects whose names are not snapshot names.
+func (store *BucketSnapshotStore) List(ctx context.Context, l log.Logger) ([]string, error) {
+	keys, err := store.objects.ListObjects(ctx, l, store.prefix+"/")
+	if err != nil {
+		return nil, errors.Errorf("failed to list state snapshots under %s: %w", store.prefix, err)
+	}
+
+	var names []string
+
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, store.prefix+"/")
+		if !strings.Contains(name, "/") && IsSnapshotName(name) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// Read implements `SnapshotStore` interface.
+func (store *BucketSnapshotStore) Read(ctx context.Context, l log.Logger, name string) ([]byte, error) {
+	data, err := store.objects.GetObject(ctx, l, store.key(name))
+	if err != nil {
+		return nil, errors.Errorf("failed to read state snapshot %s: %w", name, err)
+	}
+
+	return data, nil
+}
+
+// Delete implements `SnapshotStore` interface.
+func (store *BucketSnapshotStore) Delete(ctx context.Context, l log.Logger, name string) error {
+	if err := store.objects.DeleteObject(ctx, l, store.key(name)); err != nil {
+		return errors.Errorf("failed to delete state snapshot %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// key returns the key of the object of the snapshot with the given name.
+func (store *BucketSnapshotStore) key(name string) string {
+	return path.Join(store.prefix, name)
+}
@@ -0,0 +1,123 @@
+package backend_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryObjects keeps the objects of a bucket in memory.
+type memoryObjects map[string][]byte
+
+func (objects memoryObjects) PutObject(_ context.Context, _ log.Logger, key string, data []byte) error {
+	objects[key] = data
+
+	return nil
+}
+
+func (objects memoryObjects) ListObjects(_ context.Context, _ log.Logger, prefix string) ([]string, error) {
+	var keys []string
+
+	for key := range maps.Keys(objects) {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+func (objects memoryObjects) GetObject(_ context.Context, _ log.Logger, key string) ([]byte, error) {
+	data, ok := objects[key]
+	if !ok {
+		return nil, errors.Errorf("object %s not found", key)
+	}
+
+	return data, nil
+}
+
+func (objects memoryObjects) DeleteObject(_ context.Context, _ log.Logger, key string) error {
+	delete(objects, key)
+
+	return nil
+}
+
+func TestBucketSnapshotStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := logger.CreateLogger()
+
+	objects := memoryObjects{
+		"snapshots/unit/terraform.tfstate/nested/20260101T000000.000Z-apply.tfstate": []byte("nested"),
+		"snapshots/unit/terraform.tfstate.other/20260101T000000.000Z-apply.tfstate":  []byte("other unit"),
+		"snapshots/unit/terraform.tfstate/terraform.tfstate":                         []byte("not a snapshot"),
+		"unit/terraform.tfstate": []byte("state"),
+	}
+
+	store := backend.NewBucketSnapshotStore(objects, "snapshots/unit/terraform.tfstate/")
+
+	require.NoError(t, store.Save(ctx, l, "20260102T000000.000Z-destroy.tfstate", []byte("second")))
+	require.NoError(t, store.Save(ctx, l, "20260101T000000.000Z-apply.tfstate", []byte("first")))
+
+	assert.Contains(t, slices.Collect(maps.Keys(objects)), "snapshots/unit/terraform.tfstate/20260101T000000.000Z-apply.tfstate")
+
+	names, err := store.List(ctx, l)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20260101T000000.000Z-apply.tfstate", "20260102T000000.000Z-destroy.tfstate"}, names)
+
+	data, err := store.Read(ctx, l, "20260102T000000.000Z-destroy.tfstate")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), data)
+
+	require.NoError(t, store.Delete(ctx, l, "20260101T000000.000Z-apply.tfstate"))
+
+	names, err = store.List(ctx, l)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20260102T000000.000Z-destroy.tfstate"}, names)
+
+	_, err = store.Read(ctx, l, "20260101T000000.000Z-apply.tfstate")
+	require.ErrorContains(t, err, "failed to read state snapshot 20260101T000000.000Z-apply.tfstate")
+}
+
+func TestBucketSnapshotStorePrune(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := logger.CreateLogger()
+
+	objects := memoryObjects{
+		"snapshots/unit/terraform.tfstate":      []byte("not a snapshot"),
+		"snapshots/unit/backup.tfstate":         []byte("not a snapshot"),
+		"snapshots/unit/notes-on-state.tfstate": []byte("not a snapshot"),
+	}
+
+	store := backend.NewBucketSnapshotStore(objects, "snapshots/unit")
+
+	created := time.Now()
+
+	for i := range 3 {
+		require.NoError(t, store.Save(ctx, l, snapshot.NewName(created.Add(time.Duration(i)*time.Minute), "apply"), []byte{byte('0' + i)}))
+	}
+
+	require.NoError(t, snapshot.Prune(ctx, l, store, 1))
+
+	names, err := store.List(ctx, l)
+	require.NoError(t, err)
+	assert.Equal(t, []string{snapshot.NewName(created.Add(2*time.Minute), "apply")}, names)
+
+	assert.Contains(t, objects, "snapshots/unit/terraform.tfstate")
+	assert.Contains(t, objects, "snapshots/unit/backup.tfstate")
+	assert.Contains(t, objects, "snapshots/unit/notes-on-state.tfstate")
+}
@@ -0,0 +1,52 @@
+package backend
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// StateObject is a state file stored in a backend bucket.
+type StateObject struct {
+	LastModified time.Time `json:"last_modified"`
+	Bucket       string    `json:"bucket"`
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+}
+
+// StateLocation is the place in a backend bucket where the states of a unit, one per workspace, are stored.
+type StateLocation struct {
+	Bucket string
+	// KeyPatterns are the `path.Match` patterns of the state object keys of the unit.
+	KeyPatterns []string
+}
+
+// Owns returns true if the given state object belongs to the unit.
+func (loc *StateLocation) Owns(obj *StateObject) bool {
+	if obj.Bucket != loc.Bucket {
+		return false
+	}
+
+	for _, pattern := range loc.KeyPatterns {
+		if ok, err := path.Match(pattern, obj.Key); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EscapeKeyPattern escapes the `path.Match` meta characters in the given state object key.
+func EscapeKeyPattern(key string) string {
+	var sb strings.Builder
+
+	for _, r := range key {
+		if strings.ContainsRune(`*?[]\`, r) {
+			sb.WriteRune('\\')
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,39 @@
+package backend_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateLocationOwns(t *testing.T) {
+	t.Parallel()
+
+	loc := &backend.StateLocation{
+		Bucket: "my-bucket",
+		KeyPatterns: []string{
+			backend.EscapeKeyPattern("app[1]/terraform.tfstate"),
+			backend.EscapeKeyPattern("env:") + "/*/" + backend.EscapeKeyPattern("app[1]/terraform.tfstate"),
+		},
+	}
+
+	testCases := []struct {
+		obj      *backend.StateObject
+		expected bool
+	}{
+		{&backend.StateObject{Bucket: "my-bucket", Key: "app[1]/terraform.tfstate"}, true},
+		{&backend.StateObject{Bucket: "my-bucket", Key: "env:/dev/app[1]/terraform.tfstate"}, true},
+		{&backend.StateObject{Bucket: "my-bucket", Key: "app1/terraform.tfstate"}, false},
+		{&backend.StateObject{Bucket: "my-bucket", Key: "env:/dev/nested/app[1]/terraform.tfstate"}, false},
+		{&backend.StateObject{Bucket: "other-bucket", Key: "app[1]/terraform.tfstate"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.obj.Bucket+"/"+tc.obj.Key, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, loc.Owns(tc.obj))
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package remotestate
 
 import (
+	"maps"
+	"net/url"
+	"slices"
+
+	"github.com/gruntwork-io/terragrunt/codegen"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -11,7 +17,14 @@ type RemoteEncryptionConfig interface {
 }
 
 type RemoteEncryptionKeyProvider interface {
-	RemoteEncryptionKeyProviderPBKDF2 | RemoteEncryptionKeyProviderGCPKMS | RemoteEncryptionKeyProviderAWSKMS
+	RemoteEncryptionKeyProviderPBKDF2 | RemoteEncryptionKeyProviderGCPKMS | RemoteEncryptionKeyProviderAWSKMS |
+		RemoteEncryptionKeyProviderOpenBao | RemoteEncryptionKeyProviderExternal
+}
+
+// remoteEncryptionKeyProviderValidator is implemented by the key providers that validate their properties
+// beyond their types.
+type remoteEncryptionKeyProviderValidator interface {
+	Validate() error
 }
 
 type RemoteEncryptionKeyProviderBase struct {
@@ -47,6 +60,12 @@ func (b *GenericRemoteEncryptionKeyProvider[T]) UnmarshalConfig(encryptionConfig
 		return errors.Errorf("failed to decode key provider properties: %w", err)
 	}
 
+	if validator, ok := any(&b.Data).(remoteEncryptionKeyProviderValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return errors.Errorf("invalid key provider properties: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -73,6 +92,90 @@ func (*RemoteEncryptionKeyProviderAWSKMS) Name() string {
 	return "aws_kms"
 }
 
+func (*RemoteEncryptionKeyProviderOpenBao) Name() string {
+	return "openbao"
+}
+
+func (*RemoteEncryptionKeyProviderExternal) Name() string {
+	return "external"
+}
+
+// EncryptionToMap validates the properties of the key provider of the given `remote_state` encryption config, and
+// of its fallback key provider if any, and returns them as the map rendered into the generated `encryption` block.
+// Returns nil if the encryption config is empty.
+func EncryptionToMap(l log.Logger, encryption map[string]any) (map[string]any, error) {
+	switch {
+	case encryption == nil:
+		l.Debug("No encryption block in remote_state config")
+
+		return nil, nil
+	case len(encryption) == 0:
+		l.Debug("Empty encryption block in remote_state config")
+
+		return nil, nil
+	}
+
+	encryption = maps.Clone(encryption)
+
+	fallback, hasFallback := encryption[codegen.EncryptionFallbackKey]
+	delete(encryption, codegen.EncryptionFallbackKey)
+
+	result, err := keyProviderToMap(encryption)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasFallback {
+		return result, nil
+	}
+
+	fallbackEncryption, ok := fallback.(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("%s in encryption config must be a map", codegen.EncryptionFallbackKey)
+	}
+
+	if result[codegen.EncryptionFallbackKey], err = keyProviderToMap(fallbackEncryption); err != nil {
+		return nil, errors.Errorf("invalid %s key provider: %w", codegen.EncryptionFallbackKey, err)
+	}
+
+	return result, nil
+}
+
+// WithoutEncryptionFallback returns the given `remote_state` encryption config without its fallback key provider.
+func WithoutEncryptionFallback(encryption map[string]any) map[string]any {
+	if _, ok := encryption[codegen.EncryptionFallbackKey]; !ok {
+		return encryption
+	}
+
+	encryption = maps.Clone(encryption)
+	delete(encryption, codegen.EncryptionFallbackKey)
+
+	return encryption
+}
+
+func keyProviderToMap(encryption map[string]any) (map[string]any, error) {
+	keyProvider, ok := encryption[codegen.EncryptionKeyProviderKey].(string)
+	if !ok {
+		return nil, errors.New("key_provider not found in encryption config")
+	}
+
+	encryptionProvider, err := NewRemoteEncryptionKeyProvider(keyProvider)
+	if err != nil {
+		return nil, errors.Errorf("error creating provider: %w", err)
+	}
+
+	if err := encryptionProvider.UnmarshalConfig(encryption); err != nil {
+		return nil, err
+	}
+
+	result, err := encryptionProvider.ToMap()
+	if err != nil {
+		return nil, errors.Errorf("error decoding struct to map: %w", err)
+	}
+
+	return result, nil
+}
+
 func NewRemoteEncryptionKeyProvider(providerType string) (RemoteEncryptionConfig, error) {
 	switch providerType {
 	case new(RemoteEncryptionKeyProviderPBKDF2).Name():
@@ -81,6 +184,10 @@ func NewRemoteEncryptionKeyProvider(providerType string) (RemoteEncryptionConfig
 		return &GenericRemoteEncryptionKeyProvider[RemoteEncryptionKeyProviderGCPKMS]{}, nil
 	case new(RemoteEncryptionKeyProviderAWSKMS).Name():
 		return &GenericRemoteEncryptionKeyProvider[RemoteEncryptionKeyProviderAWSKMS]{}, nil
+	case new(RemoteEncryptionKeyProviderOpenBao).Name():
+		return &GenericRemoteEncryptionKeyProvider[RemoteEncryptionKeyProviderOpenBao]{}, nil
+	case new(RemoteEncryptionKeyProviderExternal).Name():
+		return &GenericRemoteEncryptionKeyProvider[RemoteEncryptionKeyProviderExternal]{}, nil
 	default:
 		return nil, errors.Errorf("unknown provider type: %s", providerType)
 	}
@@ -107,3 +214,50 @@ type RemoteEncryptionKeyProviderGCPKMS struct {
 	KmsEncryptionKey                string `mapstructure:"kms_encryption_key"`
 	KeyLength                       int    `mapstructure:"key_length"`
 }
+
+// RemoteEncryptionKeyProviderOpenBao generates the keys with the transit secrets engine of OpenBao,
+// or of HashiCorp Vault, which exposes the same API.
+type RemoteEncryptionKeyProviderOpenBao struct {
+	RemoteEncryptionKeyProviderBase `mapstructure:",squash"`
+	KeyName                         string `mapstructure:"key_name"`
+	Token                           string `mapstructure:"token"`
+	Address                         string `mapstructure:"address"`
+	TransitEnginePath               string `mapstructure:"transit_engine_path"`
+	KeyLength                       int    `mapstructure:"key_length"`
+}
+
+// Validate checks that the transit key is set, and that the address and key length are valid.
+func (provider *RemoteEncryptionKeyProviderOpenBao) Validate() error {
+	if provider.KeyName == "" {
+		return errors.New("key_name is required")
+	}
+
+	if provider.Address != "" {
+		address, err := url.Parse(provider.Address)
+		if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+			return errors.Errorf("address %q must be an http or https URL", provider.Address)
+		}
+	}
+
+	// Zero means the default key length of the provider.
+	if provider.KeyLength != 0 && !slices.Contains([]int{16, 24, 32}, provider.KeyLength) {
+		return errors.Errorf("key_length must be one of 16, 24 or 32, got %d", provider.KeyLength)
+	}
+
+	return nil
+}
+
+// RemoteEncryptionKeyProviderExternal gets the keys from an external program, run with the given command.
+type RemoteEncryptionKeyProviderExternal struct {
+	RemoteEncryptionKeyProviderBase `mapstructure:",squash"`
+	Command                         []string `mapstructure:"command"`
+}
+
+// Validate checks that the command, including the program to run, is set.
+func (provider *RemoteEncryptionKeyProviderExternal) Validate() error {
+	if len(provider.Command) == 0 || provider.Command[0] == "" {
+		return errors.New("command is required and must start with the program to run")
+	}
+
+	return nil
+}
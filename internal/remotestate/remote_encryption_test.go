@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -104,6 +105,83 @@ func TestUnmarshalConfig(t *testing.T) {
 			},
 			expectedErrorFromProvider: true,
 		},
+		{
+			name:         "OpenBao full config",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider":        "openbao",
+				"key_name":            "tofu-state",
+				"token":               "s.Ws4ixKk5Lp3nVWrQ",
+				"address":             "https://bao.example.com:8200",
+				"transit_engine_path": "/transit",
+				"key_length":          32,
+			},
+		},
+		{
+			name:         "OpenBao missing key name",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider": "openbao",
+				"address":      "https://bao.example.com:8200",
+			},
+			expectedErrorFromProvider: true,
+		},
+		{
+			name:         "OpenBao invalid address",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider": "openbao",
+				"key_name":     "tofu-state",
+				"address":      "bao.example.com:8200", // Missing scheme
+			},
+			expectedErrorFromProvider: true,
+		},
+		{
+			name:         "OpenBao invalid key length",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider": "openbao",
+				"key_name":     "tofu-state",
+				"key_length":   64,
+			},
+			expectedErrorFromProvider: true,
+		},
+		{
+			name:         "OpenBao invalid property",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider": "openbao",
+				"key_name":     "tofu-state",
+				"namespace":    "admin", // Invalid property
+			},
+			expectedErrorFromProvider: true,
+		},
+		{
+			name:         "External full config",
+			providerType: "external",
+			encryptionConfig: map[string]any{
+				"key_provider": "external",
+				"command":      []any{"./get-key.sh", "--format", "json"},
+			},
+		},
+		{
+			name:         "External missing command",
+			providerType: "external",
+			encryptionConfig: map[string]any{
+				"key_provider": "external",
+				"command":      []any{},
+			},
+			expectedErrorFromProvider: true,
+		},
+		{
+			name:         "External invalid config",
+			providerType: "external",
+			encryptionConfig: map[string]any{
+				"key_provider": "external",
+				"command":      "./get-key.sh", // Invalid type
+			},
+			expectedErrorFromProvider: true,
+		},
 		{
 			name:         "Unknown provider",
 			providerType: "unknown",
@@ -216,6 +294,36 @@ func TestToMap(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name:         "OpenBao partial config",
+			providerType: "openbao",
+			encryptionConfig: map[string]any{
+				"key_provider": "openbao",
+				"key_name":     "tofu-state",
+			},
+			expectedMap: map[string]any{
+				"key_provider":        "openbao",
+				"key_name":            "tofu-state",
+				"token":               "",
+				"address":             "",
+				"transit_engine_path": "",
+				"key_length":          0,
+			},
+			expectedError: false,
+		},
+		{
+			name:         "External full config",
+			providerType: "external",
+			encryptionConfig: map[string]any{
+				"key_provider": "external",
+				"command":      []any{"./get-key.sh", "--format", "json"},
+			},
+			expectedMap: map[string]any{
+				"key_provider": "external",
+				"command":      []string{"./get-key.sh", "--format", "json"},
+			},
+			expectedError: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -242,3 +350,54 @@ func TestToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptionToMap(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+
+	result, err := remotestate.EncryptionToMap(l, nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+
+	encryption := map[string]any{
+		"key_provider": "external",
+		"command":      []any{"./get-key.sh"},
+		"fallback": map[string]any{
+			"key_provider": "aws_kms",
+			"kms_key_id":   "123456789",
+		},
+	}
+
+	result, err = remotestate.EncryptionToMap(l, encryption)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"key_provider": "external",
+		"command":      []string{"./get-key.sh"},
+		"fallback": map[string]any{
+			"key_provider": "aws_kms",
+			"kms_key_id":   "123456789",
+			"key_spec":     "",
+			"region":       "",
+		},
+	}, result)
+
+	assert.Equal(t, map[string]any{
+		"key_provider": "external",
+		"command":      []any{"./get-key.sh"},
+	}, remotestate.WithoutEncryptionFallback(encryption))
+	assert.Contains(t, encryption, "fallback")
+
+	encryption["fallback"] = map[string]any{
+		"key_provider": "aws_kms",
+		"password":     "password123", // Invalid property
+	}
+
+	_, err = remotestate.EncryptionToMap(l, encryption)
+	require.Error(t, err)
+
+	encryption["fallback"] = "pbkdf2" // Invalid type
+
+	_, err = remotestate.EncryptionToMap(l, encryption)
+	require.Error(t, err)
+}
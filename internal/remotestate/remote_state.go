@@ -8,7 +8,9 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/gcs"
+	httpbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/http"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -18,6 +20,8 @@ import (
 var backends = backend.Backends{
 	s3.NewBackend(),
 	gcs.NewBackend(),
+	azurerm.NewBackend(),
+	httpbackend.NewBackend(),
 }
 
 // RemoteState is the configuration for Terraform remote state.
@@ -73,6 +77,51 @@ func (remote *RemoteState) Bootstrap(ctx context.Context, l log.Logger, opts *op
 	return remote.backend.Bootstrap(ctx, l, remote.BackendConfig, opts)
 }
 
+// Verify compares the remote state resources with the config and returns every mismatch. It never modifies the resources.
+func (remote *RemoteState) Verify(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (backend.Drifts, error) {
+	l.Debugf("Verifying remote state for the %s backend", remote.BackendName)
+
+	return remote.backend.Verify(ctx, l, remote.BackendConfig, opts)
+}
+
+// GetLock returns the lock currently held on the remote state, or nil if the state is not locked.
+func (remote *RemoteState) GetLock(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (*backend.LockInfo, error) {
+	l.Debugf("Getting state lock for the %s backend", remote.BackendName)
+
+	return remote.backend.GetLock(ctx, l, remote.BackendConfig, opts)
+}
+
+// ReleaseLock force-releases the lock held on the remote state.
+func (remote *RemoteState) ReleaseLock(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) error {
+	l.Debugf("Releasing state lock for the %s backend", remote.BackendName)
+
+	return remote.backend.ReleaseLock(ctx, l, remote.BackendConfig, opts)
+}
+
+// GetSnapshotStore returns the store that keeps the state snapshots under the given prefix of the backend bucket.
+func (remote *RemoteState) GetSnapshotStore(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, prefix string) (backend.SnapshotStore, error) {
+	return remote.backend.GetSnapshotStore(ctx, l, remote.BackendConfig, opts, prefix)
+}
+
+// GetStateLocation returns the place in the backend bucket where the states of the unit are stored.
+func (remote *RemoteState) GetStateLocation(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (*backend.StateLocation, error) {
+	return remote.backend.GetStateLocation(ctx, l, remote.BackendConfig, opts)
+}
+
+// ListStateObjects returns all the state objects with the given key prefix in the backend bucket.
+func (remote *RemoteState) ListStateObjects(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, prefix string) ([]*backend.StateObject, error) {
+	l.Debugf("Listing state objects with prefix %q in the %s backend", prefix, remote.BackendName)
+
+	return remote.backend.ListStateObjects(ctx, l, remote.BackendConfig, opts, prefix)
+}
+
+// DeleteStateObject deletes the state object with the given key from the backend bucket.
+func (remote *RemoteState) DeleteStateObject(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, key string) error {
+	l.Debugf("Deleting state object %s from the %s backend", key, remote.BackendName)
+
+	return remote.backend.DeleteStateObject(ctx, l, remote.BackendConfig, opts, key)
+}
+
 // Migrate determines where the remote state resources exist for source backend config and migrate them to dest backend config.
 func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, opts, dstOpts *options.TerragruntOptions, dstRemote *RemoteState) error {
 	l.Debugf("Migrate remote state for the %s backend", remote.BackendName)
@@ -81,7 +130,7 @@ func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, opts, dstO
 		return remote.backend.Migrate(ctx, l, remote.BackendConfig, dstRemote.BackendConfig, opts)
 	}
 
-	stateFile, err := remote.pullState(ctx, l, opts)
+	stateFile, err := remote.PullState(ctx, l, opts)
 	if err != nil {
 		return err
 	}
@@ -90,7 +139,7 @@ func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, opts, dstO
 		os.Remove(stateFile) // nolint: errcheck
 	}()
 
-	return dstRemote.pushState(ctx, l, dstOpts, stateFile)
+	return dstRemote.PushState(ctx, l, dstOpts, stateFile, false)
 }
 
 // NeedsBootstrap returns true if remote state needs to be configured. This will be the case when:
@@ -145,7 +194,9 @@ func (remote *RemoteState) GenerateOpenTofuCode(l log.Logger, opts *options.Terr
 	return remote.Config.GenerateOpenTofuCode(l, opts, backendConfig)
 }
 
-func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (string, error) {
+// PullState pulls the state of the unit initialized in `opts.WorkingDir` into a temporary file and returns its path.
+// The caller is responsible for removing the file.
+func (remote *RemoteState) PullState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (string, error) {
 	l.Debugf("Pulling state from %s backend", remote.BackendName)
 
 	args := []string{tf.CommandNameState, tf.CommandNamePull}
@@ -155,7 +206,7 @@ func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, opts *op
 		return "", err
 	}
 
-	l.Debugf("Creating temporary state file for migration")
+	l.Debugf("Creating temporary state file")
 
 	file, err := os.CreateTemp("", "*.tfstate")
 	if err != nil {
@@ -173,10 +224,18 @@ func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, opts *op
 	return file.Name(), nil
 }
 
-func (remote *RemoteState) pushState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, stateFile string) error {
+// PushState pushes the given state file to the backend of the unit initialized in `opts.WorkingDir`.
+// If `force` is true, the lineage and serial checks of OpenTofu/Terraform are skipped.
+func (remote *RemoteState) PushState(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, stateFile string, force bool) error {
 	l.Debugf("Pushing state to %s backend", remote.BackendName)
 
-	args := []string{tf.CommandNameState, tf.CommandNamePush, stateFile}
+	args := []string{tf.CommandNameState, tf.CommandNamePush}
+
+	if force {
+		args = append(args, tf.FlagNameForce)
+	}
+
+	args = append(args, stateFile)
 
 	return tf.RunCommand(ctx, l, opts, args...)
 }
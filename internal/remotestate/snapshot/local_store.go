@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// State files may contain secrets, so the snapshots are only accessible by the owner.
+	ownerReadWritePerms        = 0600
+	ownerReadWriteExecutePerms = 0700
+)
+
+var _ backend.SnapshotStore = new(LocalStore)
+
+// LocalStore stores the state snapshots of a unit as files in a local directory.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore returns a new snapshot store that keeps the snapshots in the given directory.
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{dir: dir}
+}
+
+// Save implements `backend.SnapshotStore` interface.
+func (store *LocalStore) Save(ctx context.Context, l log.Logger, name string, data []byte) error {
+	if err := os.MkdirAll(store.dir, ownerReadWriteExecutePerms); err != nil {
+		return errors.New(err)
+	}
+
+	path := filepath.Join(store.dir, name)
+
+	l.Debugf("Saving state snapshot to %s", path)
+
+	return errors.New(os.WriteFile(path, data, ownerReadWritePerms))
+}
+
+// List implements `backend.SnapshotStore` interface. The files whose names are not snapshot names are skipped.
+func (store *LocalStore) List(ctx context.Context, l log.Logger) ([]string, error) {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, errors.New(err)
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() && IsName(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Read implements `backend.SnapshotStore` interface.
+func (store *LocalStore) Read(ctx context.Context, l log.Logger, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(store.dir, name))
+	if err != nil {
+		return nil, errors.New(err)
+	}
+
+	return data, nil
+}
+
+// Delete implements `backend.SnapshotStore` interface.
+func (store *LocalStore) Delete(ctx context.Context, l log.Logger, name string) error {
+	return errors.New(os.Remove(filepath.Join(store.dir, name)))
+}
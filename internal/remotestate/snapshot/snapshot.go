@@ -0,0 +1,170 @@
+// Package snapshot provides the ability to snapshot the state of a unit before it is mutated and to restore it later.
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/errors"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// FileExt is the extension of the snapshot files.
+	FileExt = backend.SnapshotFileExt
+
+	nameTimeFormat = backend.SnapshotNameTimeFormat
+)
+
+// Enabled returns true if a snapshot directory or bucket prefix is configured.
+func Enabled(opts *options.TerragruntOptions) bool {
+	return opts.StateSnapshotDir != "" || opts.StateSnapshotBucketPrefix != ""
+}
+
+// NewName returns the name of the snapshot taken at the given time before running the given command.
+func NewName(created time.Time, command string) string {
+	return created.UTC().Format(nameTimeFormat) + "-" + command + FileExt
+}
+
+// ParseTime returns the time the snapshot with the given name was taken.
+func ParseTime(name string) (time.Time, error) {
+	timestamp, _, _ := strings.Cut(name, "-")
+
+	created, err := time.Parse(nameTimeFormat, timestamp)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid state snapshot name %s: %w", name, err)
+	}
+
+	return created, nil
+}
+
+// IsName returns true if the given object or file name is the name of a snapshot, as returned by `NewName`.
+func IsName(name string) bool {
+	return backend.IsSnapshotName(name)
+}
+
+// NewStore returns the snapshot store of the unit. If `opts.StateSnapshotBucketPrefix` is set, the snapshots are kept
+// in the backend bucket, otherwise in `opts.StateSnapshotDir`. A relative directory is resolved against the unit
+// directory, and an absolute one gets a subdirectory per unit, mirroring the unit path.
+func NewStore(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState) (backend.SnapshotStore, error) {
+	if opts.StateSnapshotBucketPrefix != "" {
+		return remoteState.GetSnapshotStore(ctx, l, opts, opts.StateSnapshotBucketPrefix)
+	}
+
+	if opts.StateSnapshotDir == "" {
+		return nil, errors.New("neither a state snapshot directory nor a bucket prefix is configured")
+	}
+
+	unitDir := filepath.Dir(opts.TerragruntConfigPath)
+
+	if !filepath.IsAbs(opts.StateSnapshotDir) {
+		return NewLocalStore(filepath.Join(unitDir, opts.StateSnapshotDir)), nil
+	}
+
+	unitDir = strings.TrimPrefix(unitDir[len(filepath.VolumeName(unitDir)):], string(filepath.Separator))
+
+	return NewLocalStore(filepath.Join(opts.StateSnapshotDir, unitDir)), nil
+}
+
+// Take pulls the state of the unit initialized in `opts.WorkingDir` and saves it as a new snapshot, then deletes
+// the oldest snapshots above `opts.StateSnapshotRetention`. Units without state yet are skipped.
+func Take(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState, command string) error {
+	store, err := NewStore(ctx, l, opts, remoteState)
+	if err != nil {
+		return err
+	}
+
+	stateFile, err := remoteState.PullState(ctx, l, opts)
+	if stateFile != "" {
+		defer os.Remove(stateFile) //nolint:errcheck
+	}
+
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		l.Debugf("No state to snapshot for unit %s", opts.TerragruntConfigPath)
+
+		return nil
+	}
+
+	name := NewName(time.Now(), command)
+
+	if err := store.Save(ctx, l, name, data); err != nil {
+		return err
+	}
+
+	l.Infof("Saved state snapshot %s", name)
+
+	return Prune(ctx, l, store, opts.StateSnapshotRetention)
+}
+
+// Prune deletes the oldest snapshots so that no more than `retention` snapshots are kept. A zero retention keeps all snapshots.
+func Prune(ctx context.Context, l log.Logger, store backend.SnapshotStore, retention int) error {
+	if retention <= 0 {
+		return nil
+	}
+
+	names, err := store.List(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	for len(names) > retention {
+		l.Debugf("Deleting expired state snapshot %s", names[0])
+
+		if err := store.Delete(ctx, l, names[0]); err != nil {
+			return err
+		}
+
+		names = names[1:]
+	}
+
+	return nil
+}
+
+// Latest returns the name of the most recent snapshot, or an empty string if there are no snapshots.
+func Latest(ctx context.Context, l log.Logger, store backend.SnapshotStore) (string, error) {
+	names, err := store.List(ctx, l)
+	if err != nil || len(names) == 0 {
+		return "", err
+	}
+
+	return names[len(names)-1], nil
+}
+
+// Restore pushes the given snapshot data to the backend of the unit initialized in `opts.WorkingDir`.
+// The lineage and serial checks are skipped, since the snapshot is usually older than the current state.
+func Restore(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, remoteState *remotestate.RemoteState, data []byte) error {
+	file, err := os.CreateTemp("", "*"+FileExt)
+	if err != nil {
+		return errors.New(err)
+	}
+
+	defer os.Remove(file.Name()) //nolint:errcheck
+
+	if _, err := file.Write(data); err != nil {
+		file.Close() //nolint:errcheck,gosec
+
+		return errors.New(err)
+	}
+
+	if err := file.Close(); err != nil {
+		return errors.New(err)
+	}
+
+	return remoteState.PushState(ctx, l, opts, file.Name(), true)
+}
@@ -0,0 +1,133 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/snapshot"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewName(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 3, 4, 5, 6, 7, 890_000_000, time.UTC)
+
+	name := snapshot.NewName(created, "state-mv")
+	assert.Equal(t, "20250304T050607.890Z-state-mv.tfstate", name)
+
+	parsed, err := snapshot.ParseTime(name)
+	require.NoError(t, err)
+	assert.Equal(t, created, parsed)
+
+	_, err = snapshot.ParseTime("terraform.tfstate")
+	require.Error(t, err)
+
+	assert.True(t, snapshot.IsName(name))
+	assert.False(t, snapshot.IsName("terraform.tfstate"))
+	assert.False(t, snapshot.IsName("20250304T050607.890Z.tfstate"))
+	assert.False(t, snapshot.IsName("20250304T050607.890Z-apply.tflock"))
+}
+
+func TestLocalStorePrune(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := logger.CreateLogger()
+	store := snapshot.NewLocalStore(filepath.Join(t.TempDir(), "snapshots"))
+
+	names, err := store.List(ctx, l)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	created := time.Now()
+
+	for i := range 4 {
+		require.NoError(t, store.Save(ctx, l, snapshot.NewName(created.Add(time.Duration(i)*time.Minute), "apply"), []byte{byte('0' + i)}))
+	}
+
+	require.NoError(t, snapshot.Prune(ctx, l, store, 2))
+
+	names, err = store.List(ctx, l)
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+
+	latest, err := snapshot.Latest(ctx, l, store)
+	require.NoError(t, err)
+	assert.Equal(t, names[1], latest)
+
+	data, err := store.Read(ctx, l, latest)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("3"), data)
+}
+
+func TestLocalStorePruneKeepsOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := logger.CreateLogger()
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	store := snapshot.NewLocalStore(dir)
+
+	created := time.Now()
+
+	for i := range 3 {
+		require.NoError(t, store.Save(ctx, l, snapshot.NewName(created.Add(time.Duration(i)*time.Minute), "apply"), []byte{byte('0' + i)}))
+	}
+
+	for _, name := range []string{"terraform.tfstate", "backup.tfstate"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("not a snapshot"), 0600))
+	}
+
+	require.NoError(t, snapshot.Prune(ctx, l, store, 1))
+
+	names, err := store.List(ctx, l)
+	require.NoError(t, err)
+	assert.Equal(t, []string{snapshot.NewName(created.Add(2*time.Minute), "apply")}, names)
+
+	assert.FileExists(t, filepath.Join(dir, "terraform.tfstate"))
+	assert.FileExists(t, filepath.Join(dir, "backup.tfstate"))
+}
+
+func TestNewStore(t *testing.T) {
+	t.Parallel()
+
+	unitDir := filepath.Join(t.TempDir(), "live", "unit")
+	snapshotsDir := t.TempDir()
+
+	testCases := []struct {
+		name        string
+		snapshotDir string
+		expectedDir string
+	}{
+		{
+			name:        "relative",
+			snapshotDir: ".snapshots",
+			expectedDir: filepath.Join(unitDir, ".snapshots"),
+		},
+		{
+			name:        "absolute",
+			snapshotDir: snapshotsDir,
+			expectedDir: filepath.Join(snapshotsDir, unitDir[len(filepath.VolumeName(unitDir)):]),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := options.NewTerragruntOptions()
+			opts.TerragruntConfigPath = filepath.Join(unitDir, "terragrunt.hcl")
+			opts.StateSnapshotDir = tc.snapshotDir
+
+			store, err := snapshot.NewStore(t.Context(), logger.CreateLogger(), opts, nil)
+			require.NoError(t, err)
+			assert.Equal(t, snapshot.NewLocalStore(tc.expectedDir), store)
+		})
+	}
+}
@@ -108,3 +108,42 @@ type CantParseTerraformStateFileError struct {
 func (err CantParseTerraformStateFileError) Error() string {
 	return fmt.Sprintf("Error parsing Terraform state file %s: %s", err.Path, err.UnderlyingErr.Error())
 }
+
+// ParseStateSerial returns the serial of the given OpenTofu/Terraform state.
+func ParseStateSerial(data []byte) (int64, error) {
+	var state struct {
+		Serial int64 `json:"serial"`
+	}
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return 0, errors.New(err)
+	}
+
+	return state.Serial, nil
+}
+
+// IncrementStateSerial returns the given OpenTofu/Terraform state with its serial incremented, and the new serial,
+// so that the state is persisted by the backend when it is pushed even though its content did not change.
+func IncrementStateSerial(data []byte) ([]byte, int64, error) {
+	var state map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, 0, errors.New(err)
+	}
+
+	serial, err := ParseStateSerial(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	serial++
+
+	state["serial"] = json.RawMessage(fmt.Sprint(serial))
+
+	data, err = json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return nil, 0, errors.New(err)
+	}
+
+	return append(data, '\n'), serial, nil
+}
@@ -323,3 +323,23 @@ func TestParseTerraformStateInvalid(t *testing.T) {
 	ok := errors.As(err, &jsonSyntaxError)
 	assert.True(t, ok)
 }
+
+func TestIncrementStateSerial(t *testing.T) {
+	t.Parallel()
+
+	stateFile := `{"version": 4, "terraform_version": "1.9.0", "serial": 41, "lineage": "d3c1e4f2", "outputs": {"big": {"value": 12345678901234567890}}, "resources": []}`
+
+	data, serial, err := remotestate.IncrementStateSerial([]byte(stateFile))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), serial)
+
+	actualSerial, err := remotestate.ParseStateSerial(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), actualSerial)
+
+	assert.Contains(t, string(data), `"lineage": "d3c1e4f2"`)
+	assert.Contains(t, string(data), `12345678901234567890`)
+
+	_, _, err = remotestate.IncrementStateSerial([]byte(`not-valid-json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,346 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CompareOptions are the options used to compare the runs of two reports.
+type CompareOptions struct {
+	// DurationThreshold is the minimum increase of the duration of a unit, in percent of its old duration, for the
+	// increase to be a regression.
+	DurationThreshold float64
+	// MinDurationIncrease is the minimum increase of the duration of a unit for the increase to be a regression, so
+	// that the noise in the durations of short units is ignored.
+	MinDurationIncrease time.Duration
+}
+
+// Comparison is the difference between the runs of an old report and the runs of a new report, matched by name.
+type Comparison struct {
+	// Added are the names of the units only in the new report.
+	Added []string `json:"Added"`
+	// Removed are the names of the units only in the old report.
+	Removed []string `json:"Removed"`
+	// NewlyFailing are the units that failed in the new report, but not in the old one.
+	NewlyFailing []ResultChange `json:"NewlyFailing"`
+	// NewlyFixed are the units that failed in the old report, and succeeded in the new one.
+	NewlyFixed []ResultChange `json:"NewlyFixed"`
+	// DurationRegressions are the units that succeeded in both reports, and took longer in the new one by more than
+	// the thresholds of the comparison.
+	DurationRegressions []DurationRegression `json:"DurationRegressions"`
+}
+
+// ResultChange is the change of the result of a unit between two reports.
+type ResultChange struct {
+	// Name is the name of the unit.
+	Name string `json:"Name"`
+	// OldResult is the result of the unit in the old report.
+	OldResult string `json:"OldResult"`
+	// NewResult is the result of the unit in the new report.
+	NewResult string `json:"NewResult"`
+}
+
+// DurationRegression is the increase of the duration of a unit between two reports.
+type DurationRegression struct {
+	// Name is the name of the unit.
+	Name string `json:"Name"`
+	// OldSeconds is the duration of the unit in the old report, in seconds.
+	OldSeconds float64 `json:"OldSeconds"`
+	// NewSeconds is the duration of the unit in the new report, in seconds.
+	NewSeconds float64 `json:"NewSeconds"`
+	// IncreasePercent is the increase of the duration, in percent of the old duration, rounded to one decimal.
+	IncreasePercent float64 `json:"IncreasePercent"`
+}
+
+// Compare compares the runs of an old report with the runs of a new report, such as the reports of two nightly runs.
+// Runs are matched by name, and all the differences are sorted by unit name.
+func Compare(oldRuns, newRuns []JSONRun, opts CompareOptions) *Comparison {
+	comparison := &Comparison{
+		Added:               []string{},
+		Removed:             []string{},
+		NewlyFailing:        []ResultChange{},
+		NewlyFixed:          []ResultChange{},
+		DurationRegressions: []DurationRegression{},
+	}
+
+	oldRunsByName := make(map[string]JSONRun, len(oldRuns))
+	for _, run := range oldRuns {
+		oldRunsByName[run.Name] = run
+	}
+
+	newNames := make(map[string]struct{}, len(newRuns))
+
+	for _, newRun := range newRuns {
+		newNames[newRun.Name] = struct{}{}
+
+		oldRun, ok := oldRunsByName[newRun.Name]
+		if !ok {
+			comparison.Added = append(comparison.Added, newRun.Name)
+
+			continue
+		}
+
+		change := ResultChange{Name: newRun.Name, OldResult: oldRun.Result, NewResult: newRun.Result}
+
+		switch {
+		case newRun.Result == string(ResultFailed) && oldRun.Result != string(ResultFailed):
+			comparison.NewlyFailing = append(comparison.NewlyFailing, change)
+		case oldRun.Result == string(ResultFailed) && newRun.Result == string(ResultSucceeded):
+			comparison.NewlyFixed = append(comparison.NewlyFixed, change)
+		}
+
+		if regression, ok := durationRegression(oldRun, newRun, opts); ok {
+			comparison.DurationRegressions = append(comparison.DurationRegressions, regression)
+		}
+	}
+
+	for _, oldRun := range oldRuns {
+		if _, ok := newNames[oldRun.Name]; !ok {
+			comparison.Removed = append(comparison.Removed, oldRun.Name)
+		}
+	}
+
+	slices.Sort(comparison.Added)
+	slices.Sort(comparison.Removed)
+
+	compareResultChanges := func(a, b ResultChange) int { return strings.Compare(a.Name, b.Name) }
+	slices.SortFunc(comparison.NewlyFailing, compareResultChanges)
+	slices.SortFunc(comparison.NewlyFixed, compareResultChanges)
+	slices.SortFunc(comparison.DurationRegressions, func(a, b DurationRegression) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return comparison
+}
+
+// durationRegression returns the regression of the duration of a unit that succeeded in both reports, if its
+// duration increased by more than both thresholds.
+func durationRegression(oldRun, newRun JSONRun, opts CompareOptions) (DurationRegression, bool) {
+	if oldRun.Result != string(ResultSucceeded) || newRun.Result != string(ResultSucceeded) {
+		return DurationRegression{}, false
+	}
+
+	oldDuration, newDuration := jsonRunDuration(oldRun), jsonRunDuration(newRun)
+	if oldDuration <= 0 {
+		return DurationRegression{}, false
+	}
+
+	increase := newDuration - oldDuration
+	if increase <= 0 || increase < opts.MinDurationIncrease {
+		return DurationRegression{}, false
+	}
+
+	percent := float64(increase) / float64(oldDuration) * 100 //nolint:mnd
+	if percent < opts.DurationThreshold {
+		return DurationRegression{}, false
+	}
+
+	return DurationRegression{
+		Name:            newRun.Name,
+		OldSeconds:      durationSeconds(oldDuration),
+		NewSeconds:      durationSeconds(newDuration),
+		IncreasePercent: math.Round(percent*10) / 10, //nolint:mnd
+	}, true
+}
+
+// jsonRunDuration returns the duration of a run read from a JSON report. Reports written before the duration was
+// recorded only have the start and end times of the run.
+func jsonRunDuration(run JSONRun) time.Duration {
+	if run.DurationSeconds != nil {
+		return time.Duration(*run.DurationSeconds * float64(time.Second))
+	}
+
+	return run.Ended.Sub(run.Started)
+}
+
+// HasDifferences returns true if the reports have any difference.
+func (c *Comparison) HasDifferences() bool {
+	return len(c.Added) > 0 ||
+		len(c.Removed) > 0 ||
+		len(c.NewlyFailing) > 0 ||
+		len(c.NewlyFixed) > 0 ||
+		len(c.DurationRegressions) > 0
+}
+
+// WriteHuman writes the comparison to a writer in a human-readable format, with a section for each kind of difference
+// that the reports have.
+func (c *Comparison) WriteHuman(w io.Writer, shouldColor bool) error {
+	colorizer := NewColorizer(shouldColor)
+
+	if !c.HasDifferences() {
+		_, err := io.WriteString(w, "No differences between the reports\n")
+
+		return err
+	}
+
+	const prefix = "   "
+
+	sections := []struct {
+		colorize func(string) string
+		title    string
+		lines    []string
+	}{
+		{title: "Added", colorize: colorizer.headingTitleColorizer, lines: c.Added},
+		{title: "Removed", colorize: colorizer.headingTitleColorizer, lines: c.Removed},
+		{title: "Newly failing", colorize: colorizer.failureColorizer, lines: resultChangeLines(c.NewlyFailing)},
+		{title: "Newly fixed", colorize: colorizer.successColorizer, lines: resultChangeLines(c.NewlyFixed)},
+		{title: "Duration regressions", colorize: colorizer.exitColorizer, lines: durationRegressionLines(c.DurationRegressions)},
+	}
+
+	for _, section := range sections {
+		if len(section.lines) == 0 {
+			continue
+		}
+
+		title := section.colorize(fmt.Sprintf("%s (%d)", section.title, len(section.lines)))
+
+		if _, err := fmt.Fprintln(w, title); err != nil {
+			return err
+		}
+
+		for _, line := range section.lines {
+			if _, err := fmt.Fprintln(w, prefix+line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resultChangeLines returns a line for each change of result, such as `db: succeeded -> failed`.
+func resultChangeLines(changes []ResultChange) []string {
+	lines := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		lines = append(lines, fmt.Sprintf("%s: %s -> %s", change.Name, change.OldResult, change.NewResult))
+	}
+
+	return lines
+}
+
+// durationRegressionLines returns a line for each duration regression, such as `vpc: 1m30s -> 3m0s (+100%)`.
+func durationRegressionLines(regressions []DurationRegression) []string {
+	lines := make([]string, 0, len(regressions))
+
+	for _, regression := range regressions {
+		lines = append(lines, fmt.Sprintf(
+			"%s: %s -> %s (+%s%%)",
+			regression.Name,
+			secondsDuration(regression.OldSeconds),
+			secondsDuration(regression.NewSeconds),
+			formatPercent(regression.IncreasePercent),
+		))
+	}
+
+	return lines
+}
+
+// WriteJSON writes the comparison to a writer in JSON format.
+func (c *Comparison) WriteJSON(w io.Writer) error {
+	jsonBytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(append(jsonBytes, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteMarkdown writes the comparison to a writer in Markdown format: a line counting each kind of difference,
+// followed by a section for each kind of difference that the reports have.
+func (c *Comparison) WriteMarkdown(w io.Writer) error {
+	_, err := fmt.Fprintf(
+		w,
+		"**%d added**, **%d removed**, **%d newly failing**, **%d newly fixed**, **%d duration regressions**\n",
+		len(c.Added),
+		len(c.Removed),
+		len(c.NewlyFailing),
+		len(c.NewlyFixed),
+		len(c.DurationRegressions),
+	)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+
+	for _, section := range []struct {
+		title string
+		names []string
+	}{
+		{title: "Added", names: c.Added},
+		{title: "Removed", names: c.Removed},
+	} {
+		if len(section.names) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n### %s\n\n", section.title)
+
+		for _, name := range section.names {
+			fmt.Fprintf(&sb, "- `%s`\n", markdownCell(name))
+		}
+	}
+
+	for _, section := range []struct {
+		title   string
+		changes []ResultChange
+	}{
+		{title: "Newly failing", changes: c.NewlyFailing},
+		{title: "Newly fixed", changes: c.NewlyFixed},
+	} {
+		if len(section.changes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n### %s\n\n| Unit | Old result | New result |\n| --- | --- | --- |\n", section.title)
+
+		for _, change := range section.changes {
+			fmt.Fprintf(
+				&sb,
+				"| %s | %s | %s |\n",
+				markdownCell(change.Name),
+				markdownCell(change.OldResult),
+				markdownCell(change.NewResult),
+			)
+		}
+	}
+
+	if len(c.DurationRegressions) > 0 {
+		sb.WriteString("\n### Duration regressions\n\n| Unit | Old duration | New duration | Increase |\n| --- | --: | --: | --: |\n")
+
+		for _, regression := range c.DurationRegressions {
+			fmt.Fprintf(
+				&sb,
+				"| %s | %ss | %ss | +%s%% |\n",
+				markdownCell(regression.Name),
+				formatSeconds(secondsDuration(regression.OldSeconds)),
+				formatSeconds(secondsDuration(regression.NewSeconds)),
+				formatPercent(regression.IncreasePercent),
+			)
+		}
+	}
+
+	_, err = io.WriteString(w, sb.String())
+
+	return err
+}
+
+// secondsDuration returns the duration of a number of seconds, rounded to the millisecond.
+func secondsDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+}
+
+// formatPercent formats a percentage without trailing zeros, such as `12.5` or `100`.
+func formatPercent(percent float64) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", percent), ".0")
+}
@@ -0,0 +1,222 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// junitTestSuites is the root element of a report in JUnit XML format.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+}
+
+// junitTestSuite is the single test suite of a report in JUnit XML format, with a test case for each run.
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+}
+
+// junitTestCase is the test case of a run in JUnit XML format.
+type junitTestCase struct {
+	Properties []junitProperty `xml:"properties>property"`
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+	Failure    *junitResult    `xml:"failure"`
+	Skipped    *junitResult    `xml:"skipped"`
+}
+
+// junitResult is the failure or skip of a test case in JUnit XML format.
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// junitProperty is a property of a test case in JUnit XML format.
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// junitSuiteName is the name of the test suite of the runs.
+const junitSuiteName = "terragrunt"
+
+// WriteJUnit writes the report to a writer in JUnit XML format.
+//
+// Each run is a test case of a single test suite, with its duration, and its result, reason, cause and plan changes
+// as properties.
+// Failed runs are failures, runs that exited early or were excluded are skipped, and runs that succeeded after a retry
+// or with an ignored error pass, with their reason in the output of the test case.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	summary := r.Summarize()
+
+	suite := junitTestSuite{
+		Name:      junitSuiteName,
+		Time:      formatSeconds(summary.TotalDuration()),
+		TestCases: make([]junitTestCase, 0, len(r.Runs)),
+		Tests:     summary.TotalUnits(),
+		Failures:  summary.UnitsFailed,
+		Skipped:   summary.EarlyExits + summary.Excluded,
+	}
+
+	if summary.firstRunStart != nil && !summary.firstRunStart.IsZero() {
+		suite.Timestamp = summary.firstRunStart.UTC().Format(time.RFC3339)
+	}
+
+	for _, run := range r.Runs {
+		suite.TestCases = append(suite.TestCases, r.junitTestCase(run))
+	}
+
+	suites := junitTestSuites{
+		Name:     junitSuiteName,
+		Time:     suite.Time,
+		Suites:   []junitTestSuite{suite},
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// junitTestCase returns the test case of the run.
+func (r *Report) junitTestCase(run *Run) junitTestCase {
+	run.mu.RLock()
+	defer run.mu.RUnlock()
+
+	reason, cause := r.reasonAndCause(run)
+
+	testCase := junitTestCase{
+		Name:      NameOfPath(run.Path, r.workingDir),
+		ClassName: junitSuiteName,
+		Time:      formatSeconds(runDuration(run)),
+		Properties: []junitProperty{
+			{Name: "result", Value: string(run.Result)},
+		},
+	}
+
+	if reason != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "reason", Value: reason})
+	}
+
+	if cause != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "cause", Value: cause})
+	}
+
+	if run.PlanChanges != nil {
+		testCase.Properties = append(testCase.Properties,
+			junitProperty{Name: "plan-add", Value: strconv.Itoa(run.PlanChanges.Add)},
+			junitProperty{Name: "plan-change", Value: strconv.Itoa(run.PlanChanges.Change)},
+			junitProperty{Name: "plan-destroy", Value: strconv.Itoa(run.PlanChanges.Destroy)},
+			junitProperty{Name: "plan-import", Value: strconv.Itoa(run.PlanChanges.Import)},
+			junitProperty{Name: "plan-move", Value: strconv.Itoa(run.PlanChanges.Move)},
+		)
+
+		for _, address := range run.PlanChanges.Destroyed {
+			testCase.Properties = append(testCase.Properties, junitProperty{Name: "plan-destroyed", Value: address})
+		}
+	}
+
+	message := describeResult(string(run.Result), reason, cause)
+
+	switch run.Result {
+	case ResultFailed:
+		failureType := reason
+		if failureType == "" {
+			failureType = string(ResultFailed)
+		}
+
+		testCase.Failure = &junitResult{Message: message, Type: failureType, Text: cause}
+	case ResultEarlyExit, ResultExcluded:
+		testCase.Skipped = &junitResult{Message: message}
+	case ResultSucceeded:
+		if reason != "" {
+			testCase.SystemOut = message
+		}
+	}
+
+	return testCase
+}
+
+// reasonAndCause returns the reason and the cause of the run, if any, with the cause of an ancestor error relative to
+// the working directory of the report.
+func (r *Report) reasonAndCause(run *Run) (string, string) {
+	reason, cause := "", ""
+
+	if run.Reason != nil {
+		reason = string(*run.Reason)
+	}
+
+	if run.Cause != nil {
+		cause = string(*run.Cause)
+
+		if reason == string(ReasonAncestorError) && r.workingDir != "" {
+			cause = strings.TrimPrefix(cause, r.workingDir+string(os.PathSeparator))
+		}
+	}
+
+	return reason, cause
+}
+
+// describeResult returns a sentence describing the result of a run with its reason and cause, such as
+// `early exit: ancestor error (vpc)`.
+func describeResult(result, reason, cause string) string {
+	switch {
+	case reason != "" && cause != "":
+		return fmt.Sprintf("%s: %s (%s)", result, reason, cause)
+	case reason != "":
+		return fmt.Sprintf("%s: %s", result, reason)
+	case cause != "":
+		return fmt.Sprintf("%s (%s)", result, cause)
+	default:
+		return result
+	}
+}
+
+// runDuration returns the duration of the run, or zero if it did not start or end.
+func runDuration(run *Run) time.Duration {
+	if run.Started.IsZero() || run.Ended.IsZero() {
+		return 0
+	}
+
+	return run.Ended.Sub(run.Started)
+}
+
+// formatSeconds returns the duration in seconds, rounded to the millisecond.
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatFloat(durationSeconds(duration), 'f', -1, 64)
+}
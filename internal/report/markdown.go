@@ -0,0 +1,97 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes the report to a writer in Markdown format: a line counting the runs by result, followed by a
+// table with the result, duration, reason and cause of each run. If any run is a plan, the sum of the planned changes
+// is written after the counts, and the table has the plan changes of each run.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	summary := r.Summarize()
+
+	_, err := fmt.Fprintf(
+		w,
+		"**%d units** in %ss: %d succeeded, %d failed, %d early exits, %d excluded\n\n",
+		summary.TotalUnits(),
+		formatSeconds(summary.TotalDuration()),
+		summary.UnitsSucceeded,
+		summary.UnitsFailed,
+		summary.EarlyExits,
+		summary.Excluded,
+	)
+	if err != nil {
+		return err
+	}
+
+	if summary.PlanChanges != nil {
+		if _, err := fmt.Fprintf(w, "**Planned**: %s\n\n", summary.PlanChanges); err != nil {
+			return err
+		}
+	}
+
+	header := "| Unit | Result | Duration | Reason | Cause |\n| --- | --- | --: | --- | --- |\n"
+	if summary.PlanChanges != nil {
+		header = "| Unit | Result | Duration | Reason | Cause | Plan | Destroyed |\n| --- | --- | --: | --- | --- | --- | --- |\n"
+	}
+
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	for _, run := range r.Runs {
+		if err := r.writeMarkdownRow(w, run, summary.PlanChanges != nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeMarkdownRow writes the row of the run, with its plan changes if the report has any.
+func (r *Report) writeMarkdownRow(w io.Writer, run *Run, withPlanChanges bool) error {
+	run.mu.RLock()
+	defer run.mu.RUnlock()
+
+	reason, cause := r.reasonAndCause(run)
+
+	row := fmt.Sprintf(
+		"| %s | %s | %ss | %s | %s |",
+		markdownCell(NameOfPath(run.Path, r.workingDir)),
+		markdownCell(string(run.Result)),
+		formatSeconds(runDuration(run)),
+		markdownCell(reason),
+		markdownCell(cause),
+	)
+
+	if withPlanChanges {
+		planChanges, destroyed := "", make([]string, 0)
+
+		if run.PlanChanges != nil {
+			planChanges = run.PlanChanges.String()
+
+			for _, address := range run.PlanChanges.Destroyed {
+				destroyed = append(destroyed, "`"+markdownCell(address)+"`")
+			}
+		}
+
+		row += fmt.Sprintf(" %s | %s |", planChanges, strings.Join(destroyed, "<br>"))
+	}
+
+	_, err := io.WriteString(w, row+"\n")
+
+	return err
+}
+
+// markdownCell escapes the value for a cell of a Markdown table.
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "|", `\|`)
+
+	return strings.ReplaceAll(value, "\n", "<br>")
+}
@@ -0,0 +1,35 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ReadJSONFile reads the runs of a report written in JSON format from a file.
+func ReadJSONFile(path string) ([]JSONRun, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open report file: %w", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	runs, err := ReadJSON(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report file %s: %w", path, err)
+	}
+
+	return runs, nil
+}
+
+// ReadJSON reads the runs of a report written in JSON format from a reader.
+func ReadJSON(r io.Reader) ([]JSONRun, error) {
+	var runs []JSONRun
+
+	if err := json.NewDecoder(r).Decode(&runs); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
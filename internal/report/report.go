@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"path/filepath"
 	"slices"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 // Report captures data for a report/summary.
@@ -21,14 +23,25 @@ type Report struct {
 }
 
 // Run captures data for a run.
+//
+// The Error of a failed run, and of each of its Attempts, is truncated to maxErrorLength.
 type Run struct {
-	Started time.Time
-	Ended   time.Time
-	Reason  *Reason
-	Cause   *Cause
-	Path    string
-	Result  Result
-	mu      sync.RWMutex
+	Started              time.Time
+	Ended                time.Time
+	Reason               *Reason
+	Cause                *Cause
+	Selection            *Selection
+	Path                 string
+	Result               Result
+	Fingerprint          string
+	ConcurrencyGroup     string
+	ConcurrencyGroupWait time.Duration
+	PlanChanges          *PlanChanges
+	Error                string
+	Attempts             []Attempt
+	Hooks                []HookRun
+	InitDuration         time.Duration
+	mu                   sync.RWMutex
 }
 
 // Result captures the result of a run.
@@ -40,12 +53,109 @@ type Reason string
 // Cause captures the cause of a run.
 type Cause string
 
+// SelectionReason captures why a unit was selected for a run by a queue filter.
+type SelectionReason string
+
+// Selection captures why a unit was selected for a run by a queue filter, and the file or unit that caused it.
+type Selection struct {
+	Reason SelectionReason
+	Cause  string
+}
+
+// PlanChanges captures the resource changes planned by a `plan` run.
+type PlanChanges struct {
+	// Destroyed are the addresses of the resources the plan destroys, including the ones it replaces.
+	Destroyed []string
+	Add       int
+	Change    int
+	Destroy   int
+	Import    int
+	Move      int
+}
+
+// String returns the changes in the format of the summary of a plan, such as
+// `1 to add, 0 to change, 2 to destroy, 1 to move`. Imports and moves are only included when there are any.
+func (changes *PlanChanges) String() string {
+	var parts []string
+
+	if changes.Import > 0 {
+		parts = append(parts, fmt.Sprintf("%d to import", changes.Import))
+	}
+
+	parts = append(parts,
+		fmt.Sprintf("%d to add", changes.Add),
+		fmt.Sprintf("%d to change", changes.Change),
+		fmt.Sprintf("%d to destroy", changes.Destroy),
+	)
+
+	if changes.Move > 0 {
+		parts = append(parts, fmt.Sprintf("%d to move", changes.Move))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Total returns the sum of the changes.
+func (changes *PlanChanges) Total() int {
+	return changes.Add + changes.Change + changes.Destroy + changes.Import + changes.Move
+}
+
+// Attempt captures an attempt of a run. A run has several attempts when it is retried because its error matched an
+// `errors.retry` block.
+type Attempt struct {
+	// RetryBlock is the name of the `errors.retry` block matching the error of the attempt, if it was retried.
+	RetryBlock string
+	// Error is the error message of the attempt, truncated to maxErrorLength, if it failed.
+	Error    string
+	Duration time.Duration
+}
+
+// HookType captures the type of a hook.
+type HookType string
+
+const (
+	HookTypeBefore HookType = "before"
+	HookTypeAfter  HookType = "after"
+	HookTypeError  HookType = "error"
+)
+
+// HookRun captures a run of a hook.
+type HookRun struct {
+	Name     string
+	Type     HookType
+	Duration time.Duration
+	// ExitCode is the exit code of the hook, or -1 if it failed without exiting, e.g. if its command was not found.
+	ExitCode int
+}
+
+// maxErrorLength is the maximum length of the error messages recorded in a report, as errors can include the whole
+// output of a command.
+const maxErrorLength = 500
+
+// truncateError returns the message of the error without colors, truncated to maxErrorLength.
+func truncateError(err error) string {
+	message := strings.TrimSpace(ansiRegex.ReplaceAllString(err.Error(), ""))
+	if len(message) <= maxErrorLength {
+		return message
+	}
+
+	// Don't cut a multi-byte character in half.
+	end := maxErrorLength
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+
+	return message[:end] + "..."
+}
+
 // Format captures the format of a report.
 type Format string
 
 const (
-	FormatCSV  Format = "csv"
-	FormatJSON Format = "json"
+	FormatCSV      Format = "csv"
+	FormatJSON     Format = "json"
+	FormatJUnit    Format = "junit"
+	FormatMarkdown Format = "markdown"
 )
 
 const (
@@ -56,13 +166,24 @@ const (
 )
 
 const (
-	ReasonRetrySucceeded  Reason = "retry succeeded"
-	ReasonErrorIgnored    Reason = "error ignored"
-	ReasonRunError        Reason = "run error"
-	ReasonExcludeDir      Reason = "--queue-exclude-dir"
-	ReasonExcludeBlock    Reason = "exclude block"
-	ReasonExcludeExternal Reason = "--queue-exclude-external"
-	ReasonAncestorError   Reason = "ancestor error"
+	ReasonRetrySucceeded   Reason = "retry succeeded"
+	ReasonErrorIgnored     Reason = "error ignored"
+	ReasonRunError         Reason = "run error"
+	ReasonExcludeDir       Reason = "--queue-exclude-dir"
+	ReasonExcludeBlock     Reason = "exclude block"
+	ReasonExcludeExternal  Reason = "--queue-exclude-external"
+	ReasonAncestorError    Reason = "ancestor error"
+	ReasonAlreadySucceeded Reason = "already succeeded"
+	ReasonQueueShard       Reason = "--queue-shard"
+	ReasonQueueFilter      Reason = "--queue-filter"
+	ReasonTimeout          Reason = "timeout"
+)
+
+const (
+	SelectionChangedDir        SelectionReason = "changed directory"
+	SelectionChangedFileRead   SelectionReason = "changed file read"
+	SelectionChangedSource     SelectionReason = "changed source"
+	SelectionChangedDependency SelectionReason = "changed dependency"
 )
 
 // NewReport creates a new report.
@@ -209,6 +330,62 @@ func (r *Report) EndRun(path string, endOptions ...EndOption) error {
 	return nil
 }
 
+// SetPlanChanges records the resource changes planned by the run.
+func (run *Run) SetPlanChanges(changes *PlanChanges) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	run.PlanChanges = changes
+}
+
+// AddAttempt records an attempt of the run that lasted for the duration, and failed with the error if it is not nil.
+func (run *Run) AddAttempt(duration time.Duration, err error) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	attempt := Attempt{Duration: duration}
+	if err != nil {
+		attempt.Error = truncateError(err)
+	}
+
+	run.Attempts = append(run.Attempts, attempt)
+}
+
+// RetryLastAttempt records that the last attempt of the run is retried because its error matched the `errors.retry`
+// block. It does nothing if the last attempt succeeded or is already retried, as the error then comes from outside of
+// the attempts, e.g. from downloading the source of the unit.
+func (run *Run) RetryLastAttempt(retryBlock string) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	if len(run.Attempts) == 0 {
+		return
+	}
+
+	last := &run.Attempts[len(run.Attempts)-1]
+	if last.Error == "" || last.RetryBlock != "" {
+		return
+	}
+
+	last.RetryBlock = retryBlock
+}
+
+// AddHook records a run of a hook.
+func (run *Run) AddHook(hook HookRun) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	run.Hooks = append(run.Hooks, hook)
+}
+
+// AddInitDuration adds the duration of a run of `init` to the time the run spent initializing, including Auto-Init.
+func (run *Run) AddInitDuration(duration time.Duration) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	run.InitDuration += duration
+}
+
 func (r *Report) SortRuns() {
 	slices.SortFunc(r.Runs, func(a, b *Run) int {
 		return a.Started.Compare(b.Started)
@@ -232,6 +409,20 @@ func WithReason(reason Reason) EndOption {
 	}
 }
 
+// WithFingerprint sets the fingerprint of a run, once it is complete.
+func WithFingerprint(fingerprint string) EndOption {
+	return func(run *Run) {
+		run.Fingerprint = fingerprint
+	}
+}
+
+// WithError sets the error message of a run, truncated to maxErrorLength.
+func WithError(err error) EndOption {
+	return func(run *Run) {
+		run.Error = truncateError(err)
+	}
+}
+
 // WithCauseRetryBlock sets the cause of a run to the name of a particular retry block.
 //
 // This function is a wrapper around withCause, just to make sure that authors always use consistent
@@ -264,6 +455,14 @@ func WithCauseAncestorExit(name string) EndOption {
 	return withCause(name)
 }
 
+// WithCauseTimeout sets the cause of a run to the duration of the timeout that expired.
+//
+// This function is a wrapper around withCause, just to make sure that authors always use consistent
+// reasons for causes.
+func WithCauseTimeout(timeout string) EndOption {
+	return withCause(timeout)
+}
+
 // WithCauseRunError sets the cause of a run to the name of a particular run error.
 //
 // This function is a wrapper around withCause, just to make sure that authors always use consistent
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -358,8 +359,8 @@ func TestWriteCSV(t *testing.T) {
 				r.EndRun(run.Path)
 			},
 			expected: [][]string{
-				{"Name", "Started", "Ended", "Result", "Reason", "Cause"},
-				{"successful-run", "", "", "succeeded", "", ""},
+				{"Name", "Started", "Ended", "Result", "Reason", "Cause", "Selection", "SelectionCause", "ConcurrencyGroup", "ConcurrencyGroupWaitSeconds", "Fingerprint", "PlanAdd", "PlanChange", "PlanDestroy", "PlanImport", "PlanMove", "PlanDestroyed", "Attempts", "AttemptSeconds", "RetryBlocks", "InitSeconds", "Hooks", "Error"},
+				{"successful-run", "", "", "succeeded", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""},
 			},
 		},
 		{
@@ -390,11 +391,25 @@ func TestWriteCSV(t *testing.T) {
 				)
 			},
 			expected: [][]string{
-				{"Name", "Started", "Ended", "Result", "Reason", "Cause"},
-				{"success-run", "", "", "succeeded", "", ""},
-				{"failed-run", "", "", "failed", "run error", ""},
-				{"excluded-run", "", "", "excluded", "", "test-block"},
-				{"early-exit-run", "", "", "early exit", "run error", "another-block"},
+				{"Name", "Started", "Ended", "Result", "Reason", "Cause", "Selection", "SelectionCause", "ConcurrencyGroup", "ConcurrencyGroupWaitSeconds", "Fingerprint", "PlanAdd", "PlanChange", "PlanDestroy", "PlanImport", "PlanMove", "PlanDestroyed", "Attempts", "AttemptSeconds", "RetryBlocks", "InitSeconds", "Hooks", "Error"},
+				{"success-run", "", "", "succeeded", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""},
+				{"failed-run", "", "", "failed", "run error", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""},
+				{"excluded-run", "", "", "excluded", "", "test-block", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""},
+				{"early-exit-run", "", "", "early exit", "run error", "another-block", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""},
+			},
+		},
+		{
+			name: "run in a concurrency group",
+			setup: func(dir string, r *report.Report) {
+				run := newRun(t, filepath.Join(dir, "grouped-run"))
+				run.ConcurrencyGroup = "database"
+				run.ConcurrencyGroupWait = 1500 * time.Millisecond
+				r.AddRun(run)
+				r.EndRun(run.Path)
+			},
+			expected: [][]string{
+				{"Name", "Started", "Ended", "Result", "Reason", "Cause", "Selection", "SelectionCause", "ConcurrencyGroup", "ConcurrencyGroupWaitSeconds", "Fingerprint", "PlanAdd", "PlanChange", "PlanDestroy", "PlanImport", "PlanMove", "PlanDestroyed", "Attempts", "AttemptSeconds", "RetryBlocks", "InitSeconds", "Hooks", "Error"},
+				{"grouped-run", "", "", "succeeded", "", "", "", "", "database", "1.5", "", "", "", "", "", "", "", "", "", "", "", "", ""},
 			},
 		},
 	}
@@ -554,6 +569,48 @@ func TestWriteJSON(t *testing.T) {
     "Reason": "exclude block",
     "Cause": "test-block"
   }
+]`,
+		},
+		{
+			name: "selected run",
+			setup: func(dir string, r *report.Report) {
+				run := newRun(t, filepath.Join(dir, "selected-run"))
+				run.Selection = &report.Selection{
+					Reason: report.SelectionChangedFileRead,
+					Cause:  filepath.Join(dir, "common.hcl"),
+				}
+				r.AddRun(run)
+				r.EndRun(run.Path)
+			},
+			expected: `[
+  {
+    "Name": "selected-run",
+    "Started": "2024-03-21T10:00:00Z",
+    "Ended": "2024-03-21T10:01:00Z",
+    "Result": "succeeded",
+    "Selection": "changed file read",
+    "SelectionCause": "common.hcl"
+  }
+]`,
+		},
+		{
+			name: "run in a concurrency group",
+			setup: func(dir string, r *report.Report) {
+				run := newRun(t, filepath.Join(dir, "grouped-run"))
+				run.ConcurrencyGroup = "database"
+				run.ConcurrencyGroupWait = 2 * time.Second
+				r.AddRun(run)
+				r.EndRun(run.Path)
+			},
+			expected: `[
+  {
+    "Name": "grouped-run",
+    "Started": "2024-03-21T10:00:00Z",
+    "Ended": "2024-03-21T10:01:00Z",
+    "Result": "succeeded",
+    "ConcurrencyGroup": "database",
+    "ConcurrencyGroupWaitSeconds": 2
+  }
 ]`,
 		},
 	}
@@ -650,6 +707,9 @@ const ExpectedSchema = `{
         "type": "string",
         "format": "date-time"
       },
+      "DurationSeconds": {
+        "type": "number"
+      },
       "Reason": {
         "type": "string",
         "enum": [
@@ -658,7 +718,11 @@ const ExpectedSchema = `{
           "run error",
           "--queue-exclude-dir",
           "exclude block",
-          "ancestor error"
+          "ancestor error",
+          "already succeeded",
+          "--queue-shard",
+          "timeout",
+          "--queue-filter"
         ]
       },
       "Cause": {
@@ -675,6 +739,120 @@ const ExpectedSchema = `{
           "early exit",
           "excluded"
         ]
+      },
+      "Selection": {
+        "type": "string",
+        "enum": [
+          "changed directory",
+          "changed file read",
+          "changed source",
+          "changed dependency"
+        ]
+      },
+      "SelectionCause": {
+        "type": "string"
+      },
+      "ConcurrencyGroup": {
+        "type": "string"
+      },
+      "ConcurrencyGroupWaitSeconds": {
+        "type": "number"
+      },
+      "Fingerprint": {
+        "type": "string"
+      },
+      "PlanChanges": {
+        "properties": {
+          "Destroyed": {
+            "items": {
+              "type": "string"
+            },
+            "type": "array"
+          },
+          "Add": {
+            "type": "integer"
+          },
+          "Change": {
+            "type": "integer"
+          },
+          "Destroy": {
+            "type": "integer"
+          },
+          "Import": {
+            "type": "integer"
+          },
+          "Move": {
+            "type": "integer"
+          }
+        },
+        "additionalProperties": false,
+        "type": "object",
+        "required": [
+          "Add",
+          "Change",
+          "Destroy",
+          "Import",
+          "Move"
+        ]
+      },
+      "Error": {
+        "type": "string"
+      },
+      "InitSeconds": {
+        "type": "number"
+      },
+      "Attempts": {
+        "items": {
+          "properties": {
+            "RetryBlock": {
+              "type": "string"
+            },
+            "Error": {
+              "type": "string"
+            },
+            "DurationSeconds": {
+              "type": "number"
+            }
+          },
+          "additionalProperties": false,
+          "type": "object",
+          "required": [
+            "DurationSeconds"
+          ]
+        },
+        "type": "array"
+      },
+      "Hooks": {
+        "items": {
+          "properties": {
+            "Name": {
+              "type": "string"
+            },
+            "Type": {
+              "type": "string",
+              "enum": [
+                "before",
+                "after",
+                "error"
+              ]
+            },
+            "DurationSeconds": {
+              "type": "number"
+            },
+            "ExitCode": {
+              "type": "integer"
+            }
+          },
+          "additionalProperties": false,
+          "type": "object",
+          "required": [
+            "Name",
+            "Type",
+            "DurationSeconds",
+            "ExitCode"
+          ]
+        },
+        "type": "array"
       }
     },
     "additionalProperties": false,
@@ -694,6 +872,39 @@ const ExpectedSchema = `{
 }
 `
 
+func TestReadJSON(t *testing.T) {
+	t.Parallel()
+
+	tmp := t.TempDir()
+
+	r := report.NewReport().WithWorkingDir(tmp)
+
+	succeededRun := newRun(t, filepath.Join(tmp, "succeeded-run"))
+	succeededRun.Fingerprint = "abc123"
+	require.NoError(t, r.AddRun(succeededRun))
+	require.NoError(t, r.EndRun(succeededRun.Path))
+
+	failedRun := newRun(t, filepath.Join(tmp, "failed-run"))
+	require.NoError(t, r.AddRun(failedRun))
+	require.NoError(t, r.EndRun(failedRun.Path, report.WithResult(report.ResultFailed), report.WithReason(report.ReasonRunError)))
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteJSON(&buf))
+
+	runs, err := report.ReadJSON(&buf)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, "succeeded-run", runs[0].Name)
+	assert.Equal(t, string(report.ResultSucceeded), runs[0].Result)
+	require.NotNil(t, runs[0].Fingerprint)
+	assert.Equal(t, "abc123", *runs[0].Fingerprint)
+
+	assert.Equal(t, "failed-run", runs[1].Name)
+	assert.Equal(t, string(report.ResultFailed), runs[1].Result)
+	assert.Nil(t, runs[1].Fingerprint)
+}
+
 func TestWriteSchema(t *testing.T) {
 	t.Parallel()
 
@@ -1225,3 +1436,477 @@ func newRun(t *testing.T, name string) *report.Run {
 
 	return run
 }
+
+// newTimedReport returns a report with a run of each result, with fixed start and end times.
+func newTimedReport(t *testing.T) *report.Report {
+	t.Helper()
+
+	tmp := t.TempDir()
+	r := report.NewReport().WithWorkingDir(tmp)
+	started := time.Date(2024, 3, 21, 10, 0, 0, 0, time.UTC)
+
+	runs := []struct {
+		name     string
+		duration time.Duration
+		options  []report.EndOption
+	}{
+		{name: "vpc", duration: 90 * time.Second},
+		{
+			name:     "db",
+			duration: 2500 * time.Millisecond,
+			options: []report.EndOption{
+				report.WithResult(report.ResultFailed),
+				report.WithReason(report.ReasonRunError),
+				report.WithCauseRunError("exit status 1"),
+			},
+		},
+		{
+			name: "app",
+			options: []report.EndOption{
+				report.WithResult(report.ResultEarlyExit),
+				report.WithReason(report.ReasonAncestorError),
+				report.WithCauseAncestorExit(filepath.Join(tmp, "db")),
+			},
+		},
+		{
+			name:     "dns",
+			duration: 10 * time.Second,
+			options: []report.EndOption{
+				report.WithResult(report.ResultSucceeded),
+				report.WithReason(report.ReasonRetrySucceeded),
+			},
+		},
+		{
+			name: "legacy|unit",
+			options: []report.EndOption{
+				report.WithResult(report.ResultExcluded),
+				report.WithReason(report.ReasonExcludeBlock),
+			},
+		},
+	}
+
+	for _, run := range runs {
+		reportRun := newRun(t, filepath.Join(tmp, run.name))
+		require.NoError(t, r.AddRun(reportRun))
+		require.NoError(t, r.EndRun(reportRun.Path, run.options...))
+
+		reportRun.Started = started
+		reportRun.Ended = started.Add(run.duration)
+	}
+
+	return r
+}
+
+func TestWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, newTimedReport(t).WriteJUnit(&buf))
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="terragrunt" time="90" tests="5" failures="1" skipped="2">
+  <testsuite name="terragrunt" time="90" timestamp="2024-03-21T10:00:00Z" tests="5" failures="1" skipped="2">
+    <testcase name="vpc" classname="terragrunt" time="90">
+      <properties>
+        <property name="result" value="succeeded"></property>
+      </properties>
+    </testcase>
+    <testcase name="db" classname="terragrunt" time="2.5">
+      <properties>
+        <property name="result" value="failed"></property>
+        <property name="reason" value="run error"></property>
+        <property name="cause" value="exit status 1"></property>
+      </properties>
+      <failure message="failed: run error (exit status 1)" type="run error">exit status 1</failure>
+    </testcase>
+    <testcase name="app" classname="terragrunt" time="0">
+      <properties>
+        <property name="result" value="early exit"></property>
+        <property name="reason" value="ancestor error"></property>
+        <property name="cause" value="db"></property>
+      </properties>
+      <skipped message="early exit: ancestor error (db)"></skipped>
+    </testcase>
+    <testcase name="dns" classname="terragrunt" time="10">
+      <properties>
+        <property name="result" value="succeeded"></property>
+        <property name="reason" value="retry succeeded"></property>
+      </properties>
+      <system-out>succeeded: retry succeeded</system-out>
+    </testcase>
+    <testcase name="legacy|unit" classname="terragrunt" time="0">
+      <properties>
+        <property name="result" value="excluded"></property>
+        <property name="reason" value="exclude block"></property>
+      </properties>
+      <skipped message="excluded: exclude block"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, newTimedReport(t).WriteMarkdown(&buf))
+
+	expected := `**5 units** in 90s: 2 succeeded, 1 failed, 1 early exits, 1 excluded
+
+| Unit | Result | Duration | Reason | Cause |
+| --- | --- | --: | --- | --- |
+| vpc | succeeded | 90s |  |  |
+| db | failed | 2.5s | run error | exit status 1 |
+| app | early exit | 0s | ancestor error | db |
+| dns | succeeded | 10s | retry succeeded |  |
+| legacy\|unit | excluded | 0s | exclude block |  |
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWritePlanChanges(t *testing.T) {
+	t.Parallel()
+
+	r := newTimedReport(t).WithDisableColor()
+
+	vpc, err := r.GetRun(r.Runs[0].Path)
+	require.NoError(t, err)
+	vpc.SetPlanChanges(&report.PlanChanges{Add: 2, Change: 1, Destroy: 1, Move: 1, Destroyed: []string{`aws_subnet.private["a|b"]`}})
+
+	dns, err := r.GetRun(r.Runs[3].Path)
+	require.NoError(t, err)
+	dns.SetPlanChanges(&report.PlanChanges{Import: 1})
+
+	t.Run("summary", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteSummary(&buf))
+		assert.Contains(t, buf.String(), "   Planned      1 to import, 2 to add, 1 to change, 1 to destroy, 1 to move\n")
+		assert.Contains(t, buf.String(), "   Destroys     1\n      vpc: aws_subnet.private[\"a|b\"]\n")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteCSV(&buf))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 6)
+		assert.Equal(t, []string{"2", "1", "1", "0", "1", `aws_subnet.private["a|b"]`}, records[1][11:17])
+		assert.Equal(t, []string{"", "", "", "", "", ""}, records[2][11:17])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteJSON(&buf))
+
+		runs, err := report.ReadJSON(&buf)
+		require.NoError(t, err)
+		require.Len(t, runs, 5)
+		assert.Equal(t, &report.JSONPlanChanges{Add: 2, Change: 1, Destroy: 1, Move: 1, Destroyed: []string{`aws_subnet.private["a|b"]`}}, runs[0].PlanChanges)
+		assert.Nil(t, runs[1].PlanChanges)
+		assert.Equal(t, &report.JSONPlanChanges{Import: 1}, runs[3].PlanChanges)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteJUnit(&buf))
+		assert.Contains(t, buf.String(), `<property name="plan-add" value="2"></property>`)
+		assert.Contains(t, buf.String(), `<property name="plan-destroyed" value="aws_subnet.private[&#34;a|b&#34;]"></property>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteMarkdown(&buf))
+		assert.Contains(t, buf.String(), "**Planned**: 1 to import, 2 to add, 1 to change, 1 to destroy, 1 to move\n")
+		assert.Contains(t, buf.String(), "| vpc | succeeded | 90s |  |  | 2 to add, 1 to change, 1 to destroy, 1 to move | `aws_subnet.private[\"a\\|b\"]` |\n")
+		assert.Contains(t, buf.String(), "| db | failed | 2.5s | run error | exit status 1 |  |  |\n")
+	})
+}
+
+func TestRunAttempts(t *testing.T) {
+	t.Parallel()
+
+	run := newRun(t, filepath.Join(t.TempDir(), "unit"))
+
+	// Nothing to retry before the first attempt, e.g. when downloading the source fails.
+	run.RetryLastAttempt("before-attempts")
+
+	run.AddAttempt(time.Second, errors.New("\x1b[31mError:\x1b[0m rate exceeded"))
+	run.RetryLastAttempt("throttling")
+	run.RetryLastAttempt("already-retried")
+	run.AddAttempt(2*time.Second, nil)
+	run.RetryLastAttempt("succeeded")
+
+	assert.Equal(t, []report.Attempt{
+		{Duration: time.Second, RetryBlock: "throttling", Error: "Error: rate exceeded"},
+		{Duration: 2 * time.Second},
+	}, run.Attempts)
+}
+
+func TestWithErrorTruncates(t *testing.T) {
+	t.Parallel()
+
+	r := report.NewReport()
+	run := newRun(t, filepath.Join(t.TempDir(), "unit"))
+	require.NoError(t, r.AddRun(run))
+
+	message := strings.Repeat("a", 499) + "é and the rest of the output"
+	require.NoError(t, r.EndRun(run.Path, report.WithResult(report.ResultFailed), report.WithError(errors.New(message))))
+
+	assert.Equal(t, strings.Repeat("a", 499)+"...", run.Error)
+}
+
+func TestWriteCSVEscapesListSeparators(t *testing.T) {
+	t.Parallel()
+
+	r := newTimedReport(t)
+
+	vpc, err := r.GetRun(r.Runs[0].Path)
+	require.NoError(t, err)
+	vpc.AddAttempt(time.Second, errors.New("Error: rate exceeded"))
+	vpc.RetryLastAttempt("api;throttling")
+	vpc.AddAttempt(time.Second, nil)
+	vpc.AddHook(report.HookRun{Name: `lint:tf;C:\tools`, Type: report.HookTypeBefore, Duration: time.Second})
+
+	var buf bytes.Buffer
+
+	require.NoError(t, r.WriteCSV(&buf))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, `api\;throttling`, records[1][19])
+	assert.Equal(t, `before:lint\:tf\;C\:\\tools:1:0`, records[1][21])
+}
+
+func TestWriteAttemptsAndHooks(t *testing.T) {
+	t.Parallel()
+
+	r := newTimedReport(t).WithDisableColor().WithShowUnitLevelSummary()
+
+	vpc, err := r.GetRun(r.Runs[0].Path)
+	require.NoError(t, err)
+	vpc.AddInitDuration(12 * time.Second)
+	vpc.AddAttempt(20*time.Second, errors.New("Error: rate exceeded"))
+	vpc.RetryLastAttempt("throttling")
+	vpc.AddAttempt(58*time.Second, nil)
+	vpc.AddHook(report.HookRun{Name: "fmt", Type: report.HookTypeBefore, Duration: 4 * time.Minute})
+	vpc.AddHook(report.HookRun{Name: "notify", Type: report.HookTypeAfter, Duration: 1500 * time.Millisecond, ExitCode: -1})
+
+	db, err := r.GetRun(r.Runs[1].Path)
+	require.NoError(t, err)
+	db.AddAttempt(2*time.Second, errors.New("exit status 1"))
+	report.WithError(errors.New("Error: invalid reference\nmore details"))(db)
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteCSV(&buf))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 6)
+		assert.Equal(t, []string{"Attempts", "AttemptSeconds", "RetryBlocks", "InitSeconds", "Hooks", "Error"}, records[0][17:])
+		assert.Equal(t, []string{"2", "20;58", "throttling", "12", "before:fmt:240:0;after:notify:1.5:-1", ""}, records[1][17:])
+		assert.Equal(t, []string{"1", "2", "", "", "", "Error: invalid reference\nmore details"}, records[2][17:])
+		assert.Equal(t, []string{"", "", "", "", "", ""}, records[3][17:])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteJSON(&buf))
+
+		runs, err := report.ReadJSON(&buf)
+		require.NoError(t, err)
+		require.Len(t, runs, 5)
+
+		throttling, rateExceeded := "throttling", "Error: rate exceeded"
+		initSeconds := 12.0
+
+		assert.Equal(t, &initSeconds, runs[0].InitSeconds)
+		assert.Nil(t, runs[0].Error)
+		assert.Equal(t, []report.JSONAttempt{
+			{DurationSeconds: 20, RetryBlock: &throttling, Error: &rateExceeded},
+			{DurationSeconds: 58},
+		}, runs[0].Attempts)
+		assert.Equal(t, []report.JSONHook{
+			{Name: "fmt", Type: "before", DurationSeconds: 240},
+			{Name: "notify", Type: "after", DurationSeconds: 1.5, ExitCode: -1},
+		}, runs[0].Hooks)
+
+		require.NotNil(t, runs[1].Error)
+		assert.Equal(t, "Error: invalid reference\nmore details", *runs[1].Error)
+		assert.Nil(t, runs[1].InitSeconds)
+		assert.Nil(t, runs[3].Attempts)
+		assert.Nil(t, runs[3].Hooks)
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, r.WriteSummary(&buf))
+		assert.Contains(t, buf.String(), "      vpc .............. 1m\n"+
+			"         init 12s\n"+
+			"         attempt 1 20s, retried by \"throttling\"\n"+
+			"         attempt 2 58s\n"+
+			"         before_hook \"fmt\" 4m, exit code 0\n"+
+			"         after_hook \"notify\" 1s, exit code -1\n")
+		assert.Contains(t, buf.String(), "      db ............... 2s\n"+
+			"         error: Error: invalid reference\n")
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	seconds := func(s float64) *float64 { return &s }
+	started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	oldRuns := []report.JSONRun{
+		{Name: "vpc", Result: "succeeded", DurationSeconds: seconds(60)},
+		{Name: "db", Result: "succeeded", DurationSeconds: seconds(10)},
+		{Name: "app", Result: "failed", DurationSeconds: seconds(5)},
+		{Name: "legacy", Result: "succeeded", DurationSeconds: seconds(1)},
+		{Name: "dns", Result: "succeeded", Started: started, Ended: started.Add(100 * time.Second)},
+		{Name: "cache", Result: "succeeded", DurationSeconds: seconds(1)},
+		{Name: "queue", Result: "excluded"},
+	}
+	newRuns := []report.JSONRun{
+		{Name: "vpc", Result: "succeeded", DurationSeconds: seconds(90)},
+		{Name: "db", Result: "failed", DurationSeconds: seconds(12)},
+		{Name: "app", Result: "succeeded", DurationSeconds: seconds(5)},
+		{Name: "dns", Result: "succeeded", Started: started, Ended: started.Add(105 * time.Second)},
+		{Name: "cache", Result: "succeeded", DurationSeconds: seconds(5)},
+		{Name: "queue", Result: "early exit"},
+		{Name: "peering", Result: "succeeded", DurationSeconds: seconds(3)},
+	}
+
+	comparison := report.Compare(oldRuns, newRuns, report.CompareOptions{
+		DurationThreshold:   20,
+		MinDurationIncrease: 10 * time.Second,
+	})
+
+	assert.True(t, comparison.HasDifferences())
+	assert.Equal(t, []string{"peering"}, comparison.Added)
+	assert.Equal(t, []string{"legacy"}, comparison.Removed)
+	assert.Equal(t, []report.ResultChange{{Name: "db", OldResult: "succeeded", NewResult: "failed"}}, comparison.NewlyFailing)
+	assert.Equal(t, []report.ResultChange{{Name: "app", OldResult: "failed", NewResult: "succeeded"}}, comparison.NewlyFixed)
+	assert.Equal(t, []report.DurationRegression{
+		{Name: "vpc", OldSeconds: 60, NewSeconds: 90, IncreasePercent: 50},
+	}, comparison.DurationRegressions)
+
+	t.Run("human", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, comparison.WriteHuman(&buf, false))
+
+		expected := `Added (1)
+   peering
+Removed (1)
+   legacy
+Newly failing (1)
+   db: succeeded -> failed
+Newly fixed (1)
+   app: failed -> succeeded
+Duration regressions (1)
+   vpc: 1m0s -> 1m30s (+50%)
+`
+
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("human colored", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, comparison.WriteHuman(&buf, true))
+
+		// Each title is colorized once, without nested escape sequences.
+		for line := range strings.SplitSeq(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			if strings.HasPrefix(line, " ") {
+				continue
+			}
+
+			assert.Equal(t, 1, strings.Count(line, "\x1b[0m"), "%q", line)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, comparison.WriteJSON(&buf))
+
+		var parsed report.Comparison
+
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &parsed))
+		assert.Equal(t, *comparison, parsed)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		require.NoError(t, comparison.WriteMarkdown(&buf))
+
+		expected := "**1 added**, **1 removed**, **1 newly failing**, **1 newly fixed**, **1 duration regressions**\n" +
+			"\n### Added\n\n- `peering`\n" +
+			"\n### Removed\n\n- `legacy`\n" +
+			"\n### Newly failing\n\n| Unit | Old result | New result |\n| --- | --- | --- |\n| db | succeeded | failed |\n" +
+			"\n### Newly fixed\n\n| Unit | Old result | New result |\n| --- | --- | --- |\n| app | failed | succeeded |\n" +
+			"\n### Duration regressions\n\n| Unit | Old duration | New duration | Increase |\n| --- | --: | --: | --: |\n| vpc | 60s | 90s | +50% |\n"
+
+		assert.Equal(t, expected, buf.String())
+	})
+}
+
+func TestCompareWithoutDifferences(t *testing.T) {
+	t.Parallel()
+
+	runs := []report.JSONRun{{Name: "vpc", Result: "succeeded"}}
+	comparison := report.Compare(runs, runs, report.CompareOptions{})
+
+	assert.False(t, comparison.HasDifferences())
+
+	var buf bytes.Buffer
+
+	require.NoError(t, comparison.WriteHuman(&buf, false))
+	assert.Equal(t, "No differences between the reports\n", buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, comparison.WriteJSON(&buf))
+	assert.JSONEq(t, `{"Added":[],"Removed":[],"NewlyFailing":[],"NewlyFixed":[],"DurationRegressions":[]}`, buf.String())
+}
@@ -15,6 +15,7 @@ import (
 type Summary struct {
 	firstRunStart        *time.Time
 	lastRunEnd           *time.Time
+	PlanChanges          *PlanChanges
 	padder               string
 	workingDir           string
 	runs                 []*Run
@@ -78,6 +79,18 @@ func (s *Summary) Update(run *Run) {
 		s.Excluded++
 	}
 
+	if run.PlanChanges != nil {
+		if s.PlanChanges == nil {
+			s.PlanChanges = &PlanChanges{}
+		}
+
+		s.PlanChanges.Add += run.PlanChanges.Add
+		s.PlanChanges.Change += run.PlanChanges.Change
+		s.PlanChanges.Destroy += run.PlanChanges.Destroy
+		s.PlanChanges.Import += run.PlanChanges.Import
+		s.PlanChanges.Move += run.PlanChanges.Move
+	}
+
 	if s.firstRunStart == nil || run.Started.Before(*s.firstRunStart) {
 		s.firstRunStart = &run.Started
 	}
@@ -190,6 +203,55 @@ func (s *Summary) Write(w io.Writer) error {
 		}
 	}
 
+	return s.writePlanChanges(w, colorizer)
+}
+
+// writePlanChanges writes the sum of the resource changes planned by the runs, followed by the addresses of the
+// resources they destroy, by unit. It writes nothing if no run planned changes.
+func (s *Summary) writePlanChanges(w io.Writer, colorizer *Colorizer) error {
+	if s.PlanChanges == nil {
+		return nil
+	}
+
+	if err := s.writeSummaryEntry(
+		w,
+		colorizer.headingUnitColorizer(planLabel),
+		s.PlanChanges.String(),
+		colorizer,
+	); err != nil {
+		return err
+	}
+
+	if s.PlanChanges.Destroy == 0 {
+		return nil
+	}
+
+	if err := s.writeSummaryEntry(
+		w,
+		colorizer.failureColorizer(destroysLabel),
+		colorizer.failureUnitColorizer(strconv.Itoa(s.PlanChanges.Destroy)),
+		colorizer,
+	); err != nil {
+		return err
+	}
+
+	for _, run := range s.runs {
+		if run.PlanChanges == nil {
+			continue
+		}
+
+		name := run.Path
+		if s.workingDir != "" {
+			name = strings.TrimPrefix(name, s.workingDir+string(os.PathSeparator))
+		}
+
+		for _, address := range run.PlanChanges.Destroyed {
+			if _, err := fmt.Fprintf(w, "%s%s: %s\n", strings.Repeat(prefix, unitPrefixMultiplier), name, address); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -203,6 +265,8 @@ const (
 	failureLabel               = "Failed"
 	earlyExitLabel             = "Early Exits"
 	excludeLabel               = "Excluded"
+	planLabel                  = "Planned"
+	destroysLabel              = "Destroys"
 	separatorLineLength        = 28
 	durationAlignmentOffset    = 4
 	headerUnitCountSpacing     = 2
@@ -343,7 +407,7 @@ func (s *Summary) writeUnitLevelSummary(w io.Writer, colorizer *Colorizer) error
 		}
 	}
 
-	return nil
+	return s.writePlanChanges(w, colorizer)
 }
 
 // writeUnitDuration writes unit duration with cleaner formatting
@@ -357,17 +421,67 @@ func (s *Summary) writeUnitDuration(w io.Writer, run *Run, colorizer *Colorizer,
 
 	padding := s.unitDurationPadding(name, colorizer)
 
+	changes := ""
+	if run.PlanChanges != nil {
+		changes = fmt.Sprintf("  +%d ~%d -%d", run.PlanChanges.Add, run.PlanChanges.Change, run.PlanChanges.Destroy)
+	}
+
 	_, err := fmt.Fprintf(
-		w, "%s%s%s%s\n",
+		w, "%s%s%s%s%s\n",
 		strings.Repeat(prefix, unitPrefixMultiplier),
 		unitColorizer(name),
 		padding,
 		colorizer.colorDuration(duration),
+		changes,
 	)
 	if err != nil {
 		return err
 	}
 
+	return s.writeUnitDetails(w, run, colorizer)
+}
+
+// writeUnitDetails writes the details of a run under its duration: the time it spent running `init`, its attempts if
+// it was retried, the hooks it ran and the first line of its error.
+func (s *Summary) writeUnitDetails(w io.Writer, run *Run, colorizer *Colorizer) error {
+	var details []string
+
+	if run.InitDuration > 0 {
+		details = append(details, "init "+colorizer.colorDuration(run.InitDuration))
+	}
+
+	if len(run.Attempts) > 1 {
+		for i, attempt := range run.Attempts {
+			detail := fmt.Sprintf("attempt %d %s", i+1, colorizer.colorDuration(attempt.Duration))
+			if attempt.RetryBlock != "" {
+				detail += fmt.Sprintf(", retried by %q", attempt.RetryBlock)
+			}
+
+			details = append(details, detail)
+		}
+	}
+
+	for _, hook := range run.Hooks {
+		details = append(details, fmt.Sprintf(
+			"%s_hook %q %s, exit code %d",
+			hook.Type,
+			hook.Name,
+			colorizer.colorDuration(hook.Duration),
+			hook.ExitCode,
+		))
+	}
+
+	if run.Error != "" {
+		message, _, _ := strings.Cut(run.Error, "\n")
+		details = append(details, colorizer.failureColorizer("error")+": "+message)
+	}
+
+	for _, detail := range details {
+		if _, err := fmt.Fprintf(w, "%s%s\n", strings.Repeat(prefix, unitPrefixMultiplier+1), detail); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,14 +20,75 @@ type JSONRun struct {
 	Started time.Time `json:"Started" jsonschema:"required"`
 	// Ended is the time when the run ended.
 	Ended time.Time `json:"Ended" jsonschema:"required"`
+	// DurationSeconds is the duration of the run in seconds, rounded to the millisecond. It is missing from the
+	// reports written before it was added.
+	DurationSeconds *float64 `json:"DurationSeconds,omitempty"`
 	// Reason is the reason for the run result, if any.
-	Reason *string `json:"Reason,omitempty" jsonschema:"enum=retry succeeded,enum=error ignored,enum=run error,enum=--queue-exclude-dir,enum=exclude block,enum=ancestor error"`
+	Reason *string `json:"Reason,omitempty" jsonschema:"enum=retry succeeded,enum=error ignored,enum=run error,enum=--queue-exclude-dir,enum=exclude block,enum=ancestor error,enum=already succeeded,enum=--queue-shard,enum=timeout,enum=--queue-filter"`
 	// Cause is the cause of the run result, if any.
 	Cause *string `json:"Cause,omitempty"`
 	// Name is the name of the run.
 	Name string `json:"Name" jsonschema:"required"`
 	// Result is the result of the run.
 	Result string `json:"Result" jsonschema:"required,enum=succeeded,enum=failed,enum=early exit,enum=excluded"`
+	// Selection is the reason the unit was selected for the run by a queue filter, if any.
+	Selection *string `json:"Selection,omitempty" jsonschema:"enum=changed directory,enum=changed file read,enum=changed source,enum=changed dependency"`
+	// SelectionCause is the changed file or unit that caused the unit to be selected, if any.
+	SelectionCause *string `json:"SelectionCause,omitempty"`
+	// ConcurrencyGroup is the concurrency group of the unit, if any.
+	ConcurrencyGroup *string `json:"ConcurrencyGroup,omitempty"`
+	// ConcurrencyGroupWaitSeconds is how long the run waited because its concurrency group was at its limit.
+	ConcurrencyGroupWaitSeconds *float64 `json:"ConcurrencyGroupWaitSeconds,omitempty"`
+	// Fingerprint is a hash of the command, configuration and sources of the unit, used to resume from the report.
+	Fingerprint *string `json:"Fingerprint,omitempty"`
+	// PlanChanges are the resource changes planned by the run, if it is a plan.
+	PlanChanges *JSONPlanChanges `json:"PlanChanges,omitempty"`
+	// Error is the error message of the run, truncated to 500 bytes, if it failed.
+	Error *string `json:"Error,omitempty"`
+	// InitSeconds is how long the run spent running `init`, including Auto-Init, if it did.
+	InitSeconds *float64 `json:"InitSeconds,omitempty"`
+	// Attempts are the attempts of the run, several if it was retried because of an `errors.retry` block.
+	Attempts []JSONAttempt `json:"Attempts,omitempty"`
+	// Hooks are the hooks the run ran, in order.
+	Hooks []JSONHook `json:"Hooks,omitempty"`
+}
+
+// JSONAttempt represents an attempt of a run in JSON format.
+type JSONAttempt struct {
+	// RetryBlock is the name of the `errors.retry` block matching the error of the attempt, if it was retried.
+	RetryBlock *string `json:"RetryBlock,omitempty"`
+	// Error is the error message of the attempt, truncated to 500 bytes, if it failed.
+	Error *string `json:"Error,omitempty"`
+	// DurationSeconds is the duration of the attempt in seconds, rounded to the millisecond.
+	DurationSeconds float64 `json:"DurationSeconds"`
+}
+
+// JSONHook represents a run of a hook in JSON format.
+type JSONHook struct {
+	// Name is the name of the hook.
+	Name string `json:"Name"`
+	// Type is the type of the hook.
+	Type string `json:"Type" jsonschema:"enum=before,enum=after,enum=error"`
+	// DurationSeconds is the duration of the hook in seconds, rounded to the millisecond.
+	DurationSeconds float64 `json:"DurationSeconds"`
+	// ExitCode is the exit code of the hook, or -1 if it failed without exiting.
+	ExitCode int `json:"ExitCode"`
+}
+
+// JSONPlanChanges represents the resource changes planned by a run in JSON format.
+type JSONPlanChanges struct {
+	// Destroyed are the addresses of the resources the plan destroys, including the ones it replaces.
+	Destroyed []string `json:"Destroyed,omitempty"`
+	// Add is the number of resources the plan creates.
+	Add int `json:"Add"`
+	// Change is the number of resources the plan updates in place.
+	Change int `json:"Change"`
+	// Destroy is the number of resources the plan destroys.
+	Destroy int `json:"Destroy"`
+	// Import is the number of resources the plan imports.
+	Import int `json:"Import"`
+	// Move is the number of resources the plan moves to a new address.
+	Move int `json:"Move"`
 }
 
 // WriteToFile writes the report to a file.
@@ -45,6 +107,10 @@ func (r *Report) WriteToFile(path string) error {
 		err = r.WriteCSV(tmpFile)
 	case FormatJSON:
 		err = r.WriteJSON(tmpFile)
+	case FormatJUnit:
+		err = r.WriteJUnit(tmpFile)
+	case FormatMarkdown:
+		err = r.WriteMarkdown(tmpFile)
 	default:
 		return fmt.Errorf("unsupported format: %s", r.format)
 	}
@@ -79,6 +145,23 @@ func (r *Report) WriteCSV(w io.Writer) error {
 		"Result",
 		"Reason",
 		"Cause",
+		"Selection",
+		"SelectionCause",
+		"ConcurrencyGroup",
+		"ConcurrencyGroupWaitSeconds",
+		"Fingerprint",
+		"PlanAdd",
+		"PlanChange",
+		"PlanDestroy",
+		"PlanImport",
+		"PlanMove",
+		"PlanDestroyed",
+		"Attempts",
+		"AttemptSeconds",
+		"RetryBlocks",
+		"InitSeconds",
+		"Hooks",
+		"Error",
 	})
 	if err != nil {
 		return err
@@ -88,7 +171,7 @@ func (r *Report) WriteCSV(w io.Writer) error {
 		run.mu.RLock()
 		defer run.mu.RUnlock()
 
-		name := nameOfPath(run.Path, r.workingDir)
+		name := NameOfPath(run.Path, r.workingDir)
 
 		started := run.Started.Format(time.RFC3339)
 		ended := run.Ended.Format(time.RFC3339)
@@ -108,14 +191,81 @@ func (r *Report) WriteCSV(w io.Writer) error {
 			}
 		}
 
-		err := csvWriter.Write([]string{
+		selection, selectionCause := "", ""
+		if run.Selection != nil {
+			selection = string(run.Selection.Reason)
+			selectionCause = r.selectionCauseName(run.Selection)
+		}
+
+		concurrencyGroupWait := ""
+		if run.ConcurrencyGroup != "" {
+			concurrencyGroupWait = strconv.FormatFloat(durationSeconds(run.ConcurrencyGroupWait), 'f', -1, 64)
+		}
+
+		planChanges := []string{"", "", "", "", "", ""}
+		if run.PlanChanges != nil {
+			planChanges = []string{
+				strconv.Itoa(run.PlanChanges.Add),
+				strconv.Itoa(run.PlanChanges.Change),
+				strconv.Itoa(run.PlanChanges.Destroy),
+				strconv.Itoa(run.PlanChanges.Import),
+				strconv.Itoa(run.PlanChanges.Move),
+				strings.Join(run.PlanChanges.Destroyed, ";"),
+			}
+		}
+
+		attempts := ""
+		if len(run.Attempts) > 0 {
+			attempts = strconv.Itoa(len(run.Attempts))
+		}
+
+		var attemptSeconds, retryBlocks []string
+
+		for _, attempt := range run.Attempts {
+			attemptSeconds = append(attemptSeconds, strconv.FormatFloat(durationSeconds(attempt.Duration), 'f', -1, 64))
+
+			if attempt.RetryBlock != "" {
+				retryBlocks = append(retryBlocks, csvListEscaper.Replace(attempt.RetryBlock))
+			}
+		}
+
+		initSeconds := ""
+		if run.InitDuration > 0 {
+			initSeconds = strconv.FormatFloat(durationSeconds(run.InitDuration), 'f', -1, 64)
+		}
+
+		hooks := make([]string, 0, len(run.Hooks))
+		for _, hook := range run.Hooks {
+			hooks = append(hooks, fmt.Sprintf("%s:%s:%s:%d",
+				hook.Type,
+				csvListEscaper.Replace(hook.Name),
+				strconv.FormatFloat(durationSeconds(hook.Duration), 'f', -1, 64),
+				hook.ExitCode,
+			))
+		}
+
+		row := append([]string{
 			name,
 			started,
 			ended,
 			result,
 			reason,
 			cause,
-		})
+			selection,
+			selectionCause,
+			run.ConcurrencyGroup,
+			concurrencyGroupWait,
+			run.Fingerprint,
+		}, planChanges...)
+
+		err := csvWriter.Write(append(row,
+			attempts,
+			strings.Join(attemptSeconds, ";"),
+			strings.Join(retryBlocks, ";"),
+			initSeconds,
+			strings.Join(hooks, ";"),
+			run.Error,
+		))
 		if err != nil {
 			return err
 		}
@@ -135,7 +285,7 @@ func (r *Report) WriteJSON(w io.Writer) error {
 		run.mu.RLock()
 		defer run.mu.RUnlock()
 
-		name := nameOfPath(run.Path, r.workingDir)
+		name := NameOfPath(run.Path, r.workingDir)
 
 		jsonRun := JSONRun{
 			Name:    name,
@@ -144,6 +294,9 @@ func (r *Report) WriteJSON(w io.Writer) error {
 			Result:  string(run.Result),
 		}
 
+		duration := durationSeconds(runDuration(run))
+		jsonRun.DurationSeconds = &duration
+
 		if run.Reason != nil {
 			reason := string(*run.Reason)
 			jsonRun.Reason = &reason
@@ -158,6 +311,73 @@ func (r *Report) WriteJSON(w io.Writer) error {
 			jsonRun.Cause = &cause
 		}
 
+		if run.Selection != nil {
+			selection := string(run.Selection.Reason)
+			jsonRun.Selection = &selection
+
+			if selectionCause := r.selectionCauseName(run.Selection); selectionCause != "" {
+				jsonRun.SelectionCause = &selectionCause
+			}
+		}
+
+		if run.ConcurrencyGroup != "" {
+			concurrencyGroup := run.ConcurrencyGroup
+			concurrencyGroupWait := durationSeconds(run.ConcurrencyGroupWait)
+			jsonRun.ConcurrencyGroup = &concurrencyGroup
+			jsonRun.ConcurrencyGroupWaitSeconds = &concurrencyGroupWait
+		}
+
+		if run.Fingerprint != "" {
+			fingerprint := run.Fingerprint
+			jsonRun.Fingerprint = &fingerprint
+		}
+
+		if run.PlanChanges != nil {
+			jsonRun.PlanChanges = &JSONPlanChanges{
+				Destroyed: run.PlanChanges.Destroyed,
+				Add:       run.PlanChanges.Add,
+				Change:    run.PlanChanges.Change,
+				Destroy:   run.PlanChanges.Destroy,
+				Import:    run.PlanChanges.Import,
+				Move:      run.PlanChanges.Move,
+			}
+		}
+
+		if run.Error != "" {
+			runError := run.Error
+			jsonRun.Error = &runError
+		}
+
+		if run.InitDuration > 0 {
+			initSeconds := durationSeconds(run.InitDuration)
+			jsonRun.InitSeconds = &initSeconds
+		}
+
+		for _, attempt := range run.Attempts {
+			jsonAttempt := JSONAttempt{DurationSeconds: durationSeconds(attempt.Duration)}
+
+			if attempt.RetryBlock != "" {
+				retryBlock := attempt.RetryBlock
+				jsonAttempt.RetryBlock = &retryBlock
+			}
+
+			if attempt.Error != "" {
+				attemptError := attempt.Error
+				jsonAttempt.Error = &attemptError
+			}
+
+			jsonRun.Attempts = append(jsonRun.Attempts, jsonAttempt)
+		}
+
+		for _, hook := range run.Hooks {
+			jsonRun.Hooks = append(jsonRun.Hooks, JSONHook{
+				Name:            hook.Name,
+				Type:            string(hook.Type),
+				DurationSeconds: durationSeconds(hook.Duration),
+				ExitCode:        hook.ExitCode,
+			})
+		}
+
 		runs = append(runs, jsonRun)
 	}
 
@@ -226,7 +446,26 @@ func (r *Report) WriteSchema(w io.Writer) error {
 	return err
 }
 
-// nameOfPath returns a name for a path given a working directory.
+// selectionCauseName returns the cause of the given selection, relative to the working directory of the report if
+// it is a path under it.
+func (r *Report) selectionCauseName(selection *Selection) string {
+	if selection.Cause == "" || r.workingDir == "" {
+		return selection.Cause
+	}
+
+	return strings.TrimPrefix(selection.Cause, r.workingDir+string(os.PathSeparator))
+}
+
+// durationSeconds returns the given duration in seconds, rounded to the millisecond.
+func durationSeconds(duration time.Duration) float64 {
+	return duration.Round(time.Millisecond).Seconds()
+}
+
+// csvListEscaper escapes the separators of the items of the lists of a CSV column, like the names of the hooks, with a
+// backslash.
+var csvListEscaper = strings.NewReplacer(`\`, `\\`, ":", `\:`, ";", `\;`)
+
+// NameOfPath returns a name for a path given a working directory.
 //
 // The logic for determining the name of a given path is:
 //
@@ -236,7 +475,7 @@ func (r *Report) WriteSchema(w io.Writer) error {
 //   - If the path is not a subdirectory of the working directory, return the path as is.
 //
 //   - Otherwise, return the path relative to the working directory, with any leading slashes removed.
-func nameOfPath(path string, workingDir string) string {
+func NameOfPath(path string, workingDir string) string {
 	// If the path is the same as the working directory,
 	// return the base name of the path.
 	if path == workingDir {
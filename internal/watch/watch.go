@@ -0,0 +1,219 @@
+// Package watch provides a watcher that polls files and directories for changes.
+//
+// The watcher takes a snapshot of the watched paths, walking directories recursively, and compares it with a new
+// snapshot on each poll. A file is changed if it was created, removed, or if its content changed. Files whose
+// modification time changed, but whose content did not, like files regenerated with the same content, are not
+// changed.
+//
+// Hidden files and directories, like `.terragrunt-cache`, `.terraform` or `.git`, and state files are ignored, as
+// running OpenTofu/Terraform changes them.
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultInterval is the default interval between two polls of the watched paths.
+	DefaultInterval = 500 * time.Millisecond
+	// DefaultDebounce is the default time without any new change the watcher waits for before reporting changes.
+	DefaultDebounce = time.Second
+)
+
+// Watcher polls a set of files and directories for changes.
+type Watcher struct {
+	files    map[string]fileState
+	paths    []string
+	interval time.Duration
+	debounce time.Duration
+}
+
+// fileState is the state of a watched file in a snapshot.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+}
+
+// Option is an option of the watcher.
+type Option func(*Watcher)
+
+// WithInterval sets the interval between two polls of the watched paths.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithDebounce sets the time without any new change the watcher waits for before reporting changes.
+func WithDebounce(debounce time.Duration) Option {
+	return func(w *Watcher) {
+		w.debounce = debounce
+	}
+}
+
+// New returns a watcher of the given files and directories, taking the snapshot the following changes are detected
+// against. Paths that don't exist are watched until they are created.
+func New(paths []string, opts ...Option) *Watcher {
+	w := &Watcher{
+		interval: DefaultInterval,
+		debounce: DefaultDebounce,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	w.SetPaths(paths)
+
+	return w
+}
+
+// Paths returns the watched paths.
+func (w *Watcher) Paths() []string {
+	return slices.Clone(w.paths)
+}
+
+// SetPaths replaces the watched paths, and takes a new snapshot the following changes are detected against.
+func (w *Watcher) SetPaths(paths []string) {
+	w.paths = compactPaths(paths)
+	w.files = w.snapshot(nil)
+}
+
+// Wait blocks until at least one watched file changes, and until no other file changed for the debounce time, then
+// returns the changed files in alphabetical order. It returns the error of the context if the context is done first.
+func (w *Watcher) Wait(ctx context.Context) ([]string, error) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var (
+		changed    = make(map[string]struct{})
+		lastChange time.Time
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		files := w.snapshot(w.files)
+
+		for path := range diff(w.files, files) {
+			changed[path] = struct{}{}
+			lastChange = time.Now()
+		}
+
+		w.files = files
+
+		if len(changed) > 0 && time.Since(lastChange) >= w.debounce {
+			return slices.Sorted(maps.Keys(changed)), nil
+		}
+	}
+}
+
+// snapshot returns the state of the watched files. The hashes of the files that have the same modification time and
+// size as in the given previous snapshot are reused, so only changed files are read.
+func (w *Watcher) snapshot(previous map[string]fileState) map[string]fileState {
+	files := make(map[string]fileState)
+
+	for _, root := range w.paths {
+		_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			// Paths that can't be read, like removed ones, are missing from the snapshot.
+			if err != nil {
+				return nil //nolint:nilerr
+			}
+
+			if path != root && isIgnored(entry) {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return nil //nolint:nilerr
+			}
+
+			state := fileState{modTime: info.ModTime(), size: info.Size()}
+
+			if prev, ok := previous[path]; ok && prev.modTime.Equal(state.modTime) && prev.size == state.size {
+				state.hash = prev.hash
+			} else if data, err := os.ReadFile(path); err == nil {
+				state.hash = sha256.Sum256(data)
+			}
+
+			files[path] = state
+
+			return nil
+		})
+	}
+
+	return files
+}
+
+// diff returns the files created, removed, or whose content changed between the two snapshots.
+func diff(before, after map[string]fileState) map[string]struct{} {
+	changed := make(map[string]struct{})
+
+	for path, state := range after {
+		if prev, ok := before[path]; !ok || prev.hash != state.hash {
+			changed[path] = struct{}{}
+		}
+	}
+
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed[path] = struct{}{}
+		}
+	}
+
+	return changed
+}
+
+// isIgnored returns true for hidden files and directories, and for state files and their backups.
+func isIgnored(entry fs.DirEntry) bool {
+	name := entry.Name()
+
+	return strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".tfstate") || strings.HasSuffix(name, ".tfstate.backup")
+}
+
+// compactPaths returns the given paths cleaned and sorted, without duplicates and without the paths inside other ones,
+// as directories are watched recursively.
+func compactPaths(paths []string) []string {
+	cleaned := make([]string, 0, len(paths))
+	for _, path := range paths {
+		cleaned = append(cleaned, filepath.Clean(path))
+	}
+
+	slices.Sort(cleaned)
+
+	compacted := make([]string, 0, len(cleaned))
+
+	for _, path := range cleaned {
+		inside := slices.ContainsFunc(compacted, func(parent string) bool {
+			return path == parent || strings.HasPrefix(path, parent+string(filepath.Separator))
+		})
+
+		if !inside {
+			compacted = append(compacted, path)
+		}
+	}
+
+	return compacted
+}
@@ -0,0 +1,95 @@
+package watch_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWatcher(paths ...string) *watch.Watcher {
+	return watch.New(paths, watch.WithInterval(10*time.Millisecond), watch.WithDebounce(50*time.Millisecond))
+}
+
+func TestWatcherChanges(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	modified := filepath.Join(dir, "main.tf")
+	removed := filepath.Join(dir, "old.tf")
+	created := filepath.Join(dir, "nested", "new.tf")
+
+	require.NoError(t, os.WriteFile(modified, []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(removed, []byte("a"), 0644))
+
+	w := newTestWatcher(dir)
+
+	require.NoError(t, os.WriteFile(modified, []byte("b"), 0644))
+	require.NoError(t, os.Remove(removed))
+	require.NoError(t, os.MkdirAll(filepath.Dir(created), 0755))
+	require.NoError(t, os.WriteFile(created, []byte("a"), 0644))
+
+	changed, err := w.Wait(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{modified, created, removed}, changed)
+}
+
+func TestWatcherDebounce(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "a.tf")
+	second := filepath.Join(dir, "b.tf")
+
+	w := newTestWatcher(dir)
+
+	require.NoError(t, os.WriteFile(first, []byte("a"), 0644))
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		_ = os.WriteFile(second, []byte("a"), 0644)
+	}()
+
+	changed, err := w.Wait(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{first, second}, changed)
+}
+
+func TestWatcherIgnoresUnchangedAndHiddenFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "backend.tf")
+
+	require.NoError(t, os.WriteFile(file, []byte("a"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".terragrunt-cache"), 0755))
+
+	w := newTestWatcher(dir)
+
+	// Rewriting a file with the same content, or changing hidden and state files, is not a change.
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(file, future, future))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".terragrunt-cache", "main.tf"), []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".terraform.lock.hcl"), []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "terraform.tfstate"), []byte("a"), 0644))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+
+	_, err := w.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWatcherPaths(t *testing.T) {
+	t.Parallel()
+
+	w := newTestWatcher("/repo/unit", "/repo/unit/nested", "/repo/unit-b", "/repo/root.hcl", "/repo/unit/")
+
+	expected := []string{filepath.FromSlash("/repo/root.hcl"), filepath.FromSlash("/repo/unit"), filepath.FromSlash("/repo/unit-b")}
+	assert.Equal(t, expected, w.Paths())
+}
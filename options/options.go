@@ -54,6 +54,9 @@ const (
 
 	DefaultIAMAssumeRoleDuration = 3600
 
+	// DefaultStateSnapshotRetention is the number of state snapshots kept for each unit.
+	DefaultStateSnapshotRetention = 10
+
 	minCommandLength = 2
 
 	defaultExcludesFile = ".terragrunt-excludes"
@@ -150,6 +153,8 @@ type TerragruntOptions struct {
 	JSONOut string
 	// The path to store unpacked providers.
 	ProviderCacheDir string
+	// The path to store the on-disk cache of the results of partial parses.
+	ParseCacheDir string
 	// Custom log level for engine
 	EngineLogLevel string
 	// Path to cache directory for engine files
@@ -176,6 +181,14 @@ type TerragruntOptions struct {
 	GraphRoot string
 	// Path to the report file.
 	ReportFile string
+	// Path to the JSON report of a previous run to resume from.
+	ResumeFrom string
+	// BackendStateOut is the file, or directory when running with `--all`, to which `backend state pull` writes state.
+	BackendStateOut string
+	// StateSnapshotDir is the local directory where the state of a unit is snapshotted before mutating commands.
+	StateSnapshotDir string
+	// StateSnapshotBucketPrefix is the prefix of the backend bucket where the state of a unit is snapshotted before mutating commands.
+	StateSnapshotBucketPrefix string
 	// Report format.
 	ReportFormat report.Format
 	// Path to the report schema file.
@@ -202,12 +215,31 @@ type TerragruntOptions struct {
 	ModulesThatInclude []string
 	// When used with `run --all`, restrict the units in the stack to only those that read at least one of the files in this list.
 	UnitsReading []string
+	// When used with `run --all`, restrict the units in the stack to only those affected by the files changed between this git ref and the working tree.
+	ChangedSince string
+	// When used with `run --all`, restrict the units in the stack to only those affected by these changed files, like ChangedSince. Set by Watch.
+	ChangedFiles []string
+	// When used with `run --all`, only run the units matching at least one of these filter expressions.
+	QueueFilters []string
+	// When used with `run --all`, only run the units of this shard, given as <index>/<count>.
+	QueueShard string
+	// Path to the JSON report of a previous run, used to balance the shards of QueueShard by the duration of the units.
+	QueueShardReport string
+	// When used with `run --all`, the order in which units that are ready to run start: critical-path, alphabetical or random.
+	QueueOrder string
+	// Path to the JSON report of a previous run, used to weight the critical path QueueOrder by the duration of the units.
+	QueueOrderReport string
+	// When used with `run --all`, the maximum duration of the run. Units still running once it expires are interrupted,
+	// and the units that did not start are not run.
+	QueueTimeout time.Duration
 	// Experiments is a map of experiments, and their status.
 	Experiments experiment.Experiments `clone:"shadowcopy"`
 	// Maximum number of times to retry errors matching RetryableErrors
 	RetryMaxAttempts int
 	// Parallelism limits the number of commands to run concurrently during *-all commands
 	Parallelism int
+	// StateSnapshotRetention is the number of state snapshots kept for each unit, 0 keeps all of them.
+	StateSnapshotRetention int
 	// When searching the directory tree, this is the max folders to check before exiting with an error.
 	MaxFoldersToCheck int
 	// The port of the Terragrunt Provider Cache server.
@@ -236,8 +268,14 @@ type TerragruntOptions struct {
 	Check bool
 	// Enables caching of includes during partial parsing operations.
 	UsePartialParseConfigCache bool
+	// Enables the on-disk cache of the results of partial parses, reused across runs.
+	ParseCache bool
 	// If set to true, do not include dependencies when processing IncludeDirs
 	StrictInclude bool
+	// If set to true, also include the dependents of the units selected by ChangedSince.
+	IncludeChangedDependents bool
+	// If set to true, watch the files the units depend on, and run the command again on the units affected by each change.
+	Watch bool
 	// Disable listing of dependent modules in render json output
 	JSONDisableDependentModules bool
 	// Enables Terragrunt's provider caching.
@@ -308,6 +346,10 @@ type TerragruntOptions struct {
 	ForceBackendDelete bool
 	// ForceBackendMigrate forces the backend to be migrated, even if the bucket is not versioned.
 	ForceBackendMigrate bool
+	// ForceBackendStatePush forces the state to be pushed, even if the lineage or serial checks fail.
+	ForceBackendStatePush bool
+	// ListStateSnapshots makes `backend state restore` list the state snapshots instead of restoring one.
+	ListStateSnapshots bool
 	// SummaryDisable disables the summary output at the end of a run.
 	SummaryDisable bool
 	// SummaryPerUnit enables showing duration information for each unit in the summary.
@@ -397,6 +439,7 @@ func NewTerragruntOptionsWithWriters(stdout, stderr io.Writer) *TerragruntOption
 		ModulesThatInclude:             []string{},
 		StrictInclude:                  false,
 		Parallelism:                    DefaultParallelism,
+		StateSnapshotRetention:         DefaultStateSnapshotRetention,
 		Check:                          false,
 		Diff:                           false,
 		FetchDependencyOutputFromState: false,
@@ -772,6 +815,8 @@ func (opts *TerragruntOptions) RunWithErrorHandling(ctx context.Context, l log.L
 				); err != nil {
 					return err
 				}
+
+				run.RetryLastAttempt(action.RetryBlockName)
 			}
 
 			// Sleep before retry
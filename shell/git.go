@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/cache"
 	"github.com/gruntwork-io/terragrunt/internal/errors"
 	"github.com/gruntwork-io/terragrunt/options"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/util"
 	"github.com/hashicorp/go-version"
 )
 
@@ -53,6 +55,118 @@ func GitTopLevelDir(ctx context.Context, l log.Logger, terragruntOptions *option
 	return cmdOutput, nil
 }
 
+// GitChange is a file changed between a git ref and the working tree, as reported by `git diff --name-status`.
+type GitChange struct {
+	// Status is the change status letter, for example `A` (added), `D` (deleted), `M` (modified) or `R` (renamed).
+	Status string
+	// Path is the absolute path of the file in the working tree, or the old path for deleted files.
+	Path string
+	// OldPath is the absolute path of the file at the git ref, set only for renamed and copied files.
+	OldPath string
+}
+
+// GitDiffNameStatus returns the files changed between the given ref and the working tree of the git repository
+// containing `dir`, with renames detected. Untracked files are not reported.
+func GitDiffNameStatus(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, dir, ref string) ([]GitChange, error) {
+	topLevelDir, err := GitTopLevelDir(ctx, l, opts, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := runGitCommand(ctx, l, opts, dir, "diff", "--name-status", "--find-renames", "-z", ref, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		changes []GitChange
+		fields  = strings.Split(strings.TrimSuffix(output, "\x00"), "\x00")
+	)
+
+	for i := 0; i < len(fields); i++ {
+		status := fields[i]
+		if status == "" {
+			continue
+		}
+
+		change := GitChange{Status: status[:1]}
+
+		// Renamed and copied files are followed by the old and new paths, the others by a single path.
+		if change.Status == "R" || change.Status == "C" {
+			if i+2 >= len(fields) {
+				return nil, errors.Errorf("unexpected git diff output for %s", status)
+			}
+
+			change.OldPath = filepath.Join(topLevelDir, filepath.FromSlash(fields[i+1]))
+			change.Path = filepath.Join(topLevelDir, filepath.FromSlash(fields[i+2]))
+			i += 2
+		} else {
+			if i+1 >= len(fields) {
+				return nil, errors.Errorf("unexpected git diff output for %s", status)
+			}
+
+			change.Path = filepath.Join(topLevelDir, filepath.FromSlash(fields[i+1]))
+			i++
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// GitShowFile returns the contents of the file at the given absolute path as of the given ref.
+func GitShowFile(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, ref, path string) (string, error) {
+	dir := filepath.Dir(path)
+
+	topLevelDir, err := GitTopLevelDir(ctx, l, opts, findExistingDir(dir))
+	if err != nil {
+		return "", err
+	}
+
+	relPath, err := filepath.Rel(topLevelDir, path)
+	if err != nil {
+		return "", errors.New(err)
+	}
+
+	return runGitCommand(ctx, l, opts, topLevelDir, "show", ref+":"+filepath.ToSlash(relPath))
+}
+
+// findExistingDir returns the given directory, or its closest existing parent.
+func findExistingDir(dir string) string {
+	for !util.IsDir(dir) {
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+
+		dir = parent
+	}
+
+	return dir
+}
+
+func runGitCommand(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, dir string, args ...string) (string, error) {
+	stdout := bytes.Buffer{}
+	stderr := bytes.Buffer{}
+
+	gitOpts, err := options.NewTerragruntOptionsWithConfigPath(dir)
+	if err != nil {
+		return "", err
+	}
+
+	gitOpts.Env = opts.Env
+	gitOpts.Writer = &stdout
+	gitOpts.ErrWriter = &stderr
+
+	output, err := RunCommandWithOutput(ctx, l, gitOpts, dir, true, false, "git", args...)
+	if err != nil {
+		return "", err
+	}
+
+	return output.Stdout.String(), nil
+}
+
 // GitRepoTags fetches git repository tags from passed url.
 func GitRepoTags(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, gitRepo *url.URL) ([]string, error) {
 	repoPath := gitRepo.String()
@@ -28,6 +28,10 @@ import (
 // if it receives the signal directly from the shell, to avoid sending the second interrupt signal to `tofu`/`terraform`.
 const SignalForwardingDelay = time.Second * 15
 
+// SignalTerminateDelay is the time to wait after interrupting the subcommand, because a unit timed out, before
+// terminating it. This gives `tofu`/`terraform` time to release the state lock and exit gracefully.
+const SignalTerminateDelay = time.Second * 30
+
 // RunCommand runs the given shell command.
 func RunCommand(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, command string, args ...string) error {
 	_, err := RunCommandWithOutput(ctx, l, opts, "", false, false, command, args...)
@@ -121,6 +125,7 @@ func RunCommandWithOutput(
 			exec.WithUsePTY(needsPTY),
 			exec.WithEnv(opts.Env),
 			exec.WithForwardSignalDelay(SignalForwardingDelay),
+			exec.WithTerminateDelay(SignalTerminateDelay),
 		)
 
 		if err := cmd.Start(); err != nil { //nolint:contextcheck
@@ -0,0 +1,67 @@
+package test_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/test/benchmarks/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkQueueOrder compares the wall-clock time of each `--queue-order` on a stack with a deep chain of units,
+// whose names sort after a set of independent units.
+func BenchmarkQueueOrder(b *testing.B) {
+	const (
+		chainLength      = 4
+		independentUnits = 6
+		parallelism      = 2
+	)
+
+	// Every unit takes the same time to plan, so the time of the run only depends on the order of the units.
+	sleepHook := `
+terraform {
+	before_hook "sleep" {
+		commands = ["plan"]
+		execute  = ["sleep", "0.2"]
+	}
+}
+`
+
+	tmpDir := b.TempDir()
+
+	for i := range independentUnits {
+		unitDir := filepath.Join(tmpDir, fmt.Sprintf("independent-%d", i))
+		require.NoError(b, os.MkdirAll(unitDir, helpers.DefaultDirPermissions))
+		require.NoError(b, os.WriteFile(filepath.Join(unitDir, "terragrunt.hcl"), []byte(sleepHook), helpers.DefaultFilePermissions))
+		require.NoError(b, os.WriteFile(filepath.Join(unitDir, "main.tf"), []byte(``), helpers.DefaultFilePermissions))
+	}
+
+	for i := range chainLength {
+		tgConfig := sleepHook
+		if i > 0 {
+			tgConfig += fmt.Sprintf("\ndependencies {\n\tpaths = [\"../ordered-chain-%d\"]\n}\n", i-1)
+		}
+
+		unitDir := filepath.Join(tmpDir, fmt.Sprintf("ordered-chain-%d", i))
+		require.NoError(b, os.MkdirAll(unitDir, helpers.DefaultDirPermissions))
+		require.NoError(b, os.WriteFile(filepath.Join(unitDir, "terragrunt.hcl"), []byte(tgConfig), helpers.DefaultFilePermissions))
+		require.NoError(b, os.WriteFile(filepath.Join(unitDir, "main.tf"), []byte(``), helpers.DefaultFilePermissions))
+	}
+
+	helpers.Init(b, tmpDir)
+
+	for _, order := range []string{"alphabetical", "critical-path", "random"} {
+		b.Run(order, func(b *testing.B) {
+			for b.Loop() {
+				start := time.Now()
+
+				helpers.RunTerragruntCommand(b, "terragrunt", "run", "--all", "plan", "--non-interactive", "--working-dir", tmpDir, "--parallelism", fmt.Sprint(parallelism), "--queue-order", order)
+
+				b.ReportMetric(time.Since(start).Seconds(), "plan_s/op")
+			}
+		})
+	}
+}
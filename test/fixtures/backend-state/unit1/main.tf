@@ -0,0 +1,3 @@
+resource "terraform_data" "unit1" {
+  input = "unit1"
+}
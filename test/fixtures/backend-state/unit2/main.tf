@@ -0,0 +1,3 @@
+resource "terraform_data" "unit2" {
+  input = "unit2"
+}
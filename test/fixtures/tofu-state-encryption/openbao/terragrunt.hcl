@@ -0,0 +1,20 @@
+# Test OpenBao transit encryption with local state
+remote_state {
+  backend = "local"
+
+  generate = {
+    path      = "backend.tf"
+    if_exists = "overwrite_terragrunt"
+  }
+
+  config = {
+    path = "${get_terragrunt_dir()}/${path_relative_to_include()}/terraform.tfstate"
+  }
+
+  encryption = {
+    key_provider = "openbao"
+    address      = "__FILL_IN_BAO_ADDRESS__"
+    token        = "__FILL_IN_BAO_TOKEN__"
+    key_name     = "__FILL_IN_BAO_KEY_NAME__"
+  }
+}
@@ -0,0 +1,15 @@
+# Test rotating the key of the state encryption with local state
+remote_state {
+  backend = "local"
+
+  generate = {
+    path      = "backend.tf"
+    if_exists = "overwrite_terragrunt"
+  }
+
+  config = {
+    path = "${get_terragrunt_dir()}/terraform.tfstate"
+  }
+
+  encryption = __FILL_IN_ENCRYPTION__
+}
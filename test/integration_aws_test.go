@@ -132,6 +132,46 @@ func TestAwsBootstrapBackend(t *testing.T) {
 	}
 }
 
+func TestAwsBootstrapBackendWithLockfileAndDynamoDBTable(t *testing.T) {
+	t.Parallel()
+
+	testID := strings.ToLower(helpers.UniqueID())
+
+	s3BucketName := "terragrunt-test-bucket-" + testID
+	dynamoDBName := "terragrunt-test-dynamodb-" + testID
+
+	defer func() {
+		deleteS3Bucket(t, s3BucketName, helpers.TerraformRemoteStateS3Region)
+		cleanupTableForTest(t, dynamoDBName, helpers.TerraformRemoteStateS3Region)
+	}()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	l := logger.CreateLogger()
+
+	// OpenTofu/Terraform take both locks, so the table is still required when `use_lockfile` is set.
+	backendConfig := map[string]any{
+		"bucket":         s3BucketName,
+		"key":            "unit/terraform.tfstate",
+		"region":         helpers.TerraformRemoteStateS3Region,
+		"dynamodb_table": dynamoDBName,
+		"use_lockfile":   true,
+	}
+
+	s3Backend := s3backend.NewBackend()
+
+	require.NoError(t, s3Backend.Bootstrap(t.Context(), l, backendConfig, opts))
+
+	validateDynamoDBTableExistsAndIsTaggedAndIsSSEncrypted(t, helpers.TerraformRemoteStateS3Region, dynamoDBName, nil, false)
+
+	drifts, err := s3Backend.Verify(t.Context(), l, backendConfig, opts)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+}
+
 func TestAwsBootstrapBackendLegacyBehavior(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,113 @@
+//go:build azure
+
+package test_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Well-known Azurite development storage account, see https://learn.microsoft.com/azure/storage/common/storage-use-azurite
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	defaultAzuriteBlobEndpoint = "http://127.0.0.1:10000/" + azuriteAccountName
+)
+
+func TestAzureRMBackendBootstrapMigrateDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	containerName := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	srcCfg := azuriteBackendConfig(containerName, "src/terraform.tfstate")
+	dstCfg := azuriteBackendConfig(containerName, "dst/terraform.tfstate")
+
+	azBackend := azurerm.NewBackend()
+
+	defer func() {
+		require.NoError(t, azBackend.DeleteBucket(ctx, l, srcCfg, opts))
+	}()
+
+	needsBootstrap, err := azBackend.NeedsBootstrap(ctx, l, srcCfg, opts)
+	require.NoError(t, err)
+	assert.True(t, needsBootstrap)
+
+	require.NoError(t, azBackend.Bootstrap(ctx, l, srcCfg, opts))
+
+	needsBootstrap, err = azBackend.NeedsBootstrap(ctx, l, srcCfg, opts)
+	require.NoError(t, err)
+	assert.False(t, needsBootstrap)
+
+	client := newAzuriteClient(t)
+
+	_, err = client.UploadBuffer(ctx, containerName, "src/terraform.tfstate", []byte(`{"version": 4}`), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, azBackend.Migrate(ctx, l, srcCfg, dstCfg, opts))
+
+	assert.False(t, azuriteBlobExists(t, client, containerName, "src/terraform.tfstate"))
+	assert.True(t, azuriteBlobExists(t, client, containerName, "dst/terraform.tfstate"))
+
+	require.NoError(t, azBackend.Delete(ctx, l, dstCfg, opts))
+
+	assert.False(t, azuriteBlobExists(t, client, containerName, "dst/terraform.tfstate"))
+}
+
+func azuriteBlobEndpoint() string {
+	if endpoint := os.Getenv("AZURITE_BLOB_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+
+	return defaultAzuriteBlobEndpoint
+}
+
+func azuriteBackendConfig(containerName, key string) backend.Config {
+	return backend.Config{
+		"storage_account_name":          azuriteAccountName,
+		"access_key":                    azuriteAccountKey,
+		"container_name":                containerName,
+		"key":                           key,
+		"blob_endpoint":                 azuriteBlobEndpoint(),
+		"skip_storage_account_creation": true,
+		"skip_blob_versioning":          true,
+	}
+}
+
+func newAzuriteClient(t *testing.T) *azblob.Client {
+	t.Helper()
+
+	cred, err := azblob.NewSharedKeyCredential(azuriteAccountName, azuriteAccountKey)
+	require.NoError(t, err)
+
+	client, err := azblob.NewClientWithSharedKeyCredential(azuriteBlobEndpoint()+"/", cred, nil)
+	require.NoError(t, err)
+
+	return client
+}
+
+func azuriteBlobExists(t *testing.T, client *azblob.Client, containerName, key string) bool {
+	t.Helper()
+
+	_, err := client.ServiceClient().NewContainerClient(containerName).NewBlobClient(key).GetProperties(context.Background(), nil)
+
+	return err == nil
+}
@@ -0,0 +1,107 @@
+package test_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFixtureBackendState = "fixtures/backend-state"
+)
+
+func TestBackendStatePullPushList(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureBackendState)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureBackendState)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureBackendState)
+	unit1Path := util.JoinPath(rootPath, "unit1")
+	outPath := util.JoinPath(tmpEnvPath, "states")
+
+	helpers.RunTerragrunt(t, "terragrunt run apply --all --non-interactive --log-level debug --working-dir "+rootPath+" -- -auto-approve")
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --non-interactive --working-dir "+unit1Path)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "terraform_data.unit1")
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --all --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "unit1: terraform_data.unit1")
+	assert.Contains(t, stdout, "unit2: terraform_data.unit2")
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state pull --non-interactive --working-dir "+unit1Path)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"terraform_data"`)
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state pull --all --non-interactive --working-dir "+rootPath)
+	require.Error(t, err)
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state pull --all --non-interactive --working-dir "+rootPath+" --out "+outPath)
+	require.NoError(t, err)
+
+	for _, unit := range []string{"unit1", "unit2"} {
+		assert.FileExists(t, filepath.Join(outPath, unit, "terraform.tfstate"))
+	}
+
+	// Remove the state of unit2 and restore it from the pulled copy.
+	require.NoError(t, os.Remove(util.JoinPath(rootPath, ".state", "unit2", "terraform.tfstate")))
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --non-interactive --working-dir "+util.JoinPath(rootPath, "unit2"))
+	require.NoError(t, err)
+	assert.NotContains(t, stdout, "terraform_data.unit2")
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state push --all --non-interactive --working-dir "+rootPath+" "+outPath)
+	require.NoError(t, err)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --non-interactive --working-dir "+util.JoinPath(rootPath, "unit2"))
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "terraform_data.unit2")
+}
+
+func TestBackendStateSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureBackendState)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureBackendState)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureBackendState)
+	unit1Path := util.JoinPath(rootPath, "unit1")
+	snapshotsPath := util.JoinPath(tmpEnvPath, "snapshots")
+	snapshotFlags := " --state-snapshot-dir " + snapshotsPath + " --state-snapshot-retention 2"
+
+	// There is no state to snapshot before the first apply.
+	helpers.RunTerragrunt(t, "terragrunt run apply --non-interactive --working-dir "+unit1Path+snapshotFlags+" -- -auto-approve")
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state restore --list --non-interactive --working-dir "+unit1Path+snapshotFlags)
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(stdout))
+
+	helpers.RunTerragrunt(t, "terragrunt run state rm terraform_data.unit1 --non-interactive --working-dir "+unit1Path+snapshotFlags)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state restore --list --non-interactive --working-dir "+unit1Path+snapshotFlags)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "-state-rm.tfstate")
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --non-interactive --working-dir "+unit1Path)
+	require.NoError(t, err)
+	assert.NotContains(t, stdout, "terraform_data.unit1")
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state restore --non-interactive --working-dir "+unit1Path+snapshotFlags)
+	require.NoError(t, err)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state list --non-interactive --working-dir "+unit1Path)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "terraform_data.unit1")
+
+	// The state is snapshotted before the restore, and the retention keeps the two most recent snapshots.
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt backend state restore --list --non-interactive --working-dir "+unit1Path+snapshotFlags)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "-restore.tfstate")
+	assert.Len(t, strings.Fields(stdout), 2)
+}
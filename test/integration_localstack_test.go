@@ -0,0 +1,363 @@
+//go:build localstack
+
+package test_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/gruntwork-io/terragrunt/cli/commands/backend/lock"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+	"github.com/gruntwork-io/terragrunt/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultLocalStackEndpoint = "http://127.0.0.1:4566"
+
+func TestS3BackendVerify(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	name := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	cfg := localStackBackendConfig(name)
+
+	s3Backend := s3.NewBackend()
+
+	defer func() {
+		require.NoError(t, s3Backend.DeleteBucket(ctx, l, cfg, opts))
+	}()
+
+	drifts, err := s3Backend.Verify(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	assert.Equal(t, backend.Drifts{
+		backend.NewMissingResourceDrift("S3 bucket " + name),
+		backend.NewMissingResourceDrift("DynamoDB table " + name),
+	}, drifts)
+
+	require.NoError(t, s3Backend.Bootstrap(ctx, l, cfg, opts))
+
+	drifts, err = s3Backend.Verify(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+}
+
+func TestS3BackendVerifyFix(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	name := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	cfg := localStackBackendConfig(name)
+
+	s3Backend := s3.NewBackend()
+
+	defer func() {
+		require.NoError(t, s3Backend.DeleteBucket(ctx, l, cfg, opts))
+	}()
+
+	extS3Cfg, err := s3.Config(cfg).ExtendedS3Config(l)
+	require.NoError(t, err)
+
+	client, err := s3.NewClient(l, extS3Cfg, opts)
+	require.NoError(t, err)
+
+	// A bare bucket without versioning, KMS encryption and public access blocking, and no lock table.
+	_, err = client.CreateBucketWithContext(ctx, &awss3.CreateBucketInput{Bucket: aws.String(name)})
+	require.NoError(t, err)
+
+	rootPath := t.TempDir()
+	writeLocalStackUnit(t, rootPath, name)
+
+	cmd := "terragrunt backend verify --non-interactive --working-dir " + rootPath
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, cmd)
+	require.Error(t, err)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, cmd+" --fix")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "S3 bucket "+name+": versioning is disabled, expected enabled (fixed)")
+
+	versioning, err := client.GetBucketVersioningWithContext(ctx, &awss3.GetBucketVersioningInput{Bucket: aws.String(name)})
+	require.NoError(t, err)
+	assert.Equal(t, awss3.BucketVersioningStatusEnabled, aws.StringValue(versioning.Status))
+
+	encryption, err := client.GetBucketEncryptionWithContext(ctx, &awss3.GetBucketEncryptionInput{Bucket: aws.String(name)})
+	require.NoError(t, err)
+	require.NotEmpty(t, encryption.ServerSideEncryptionConfiguration.Rules)
+	assert.Equal(t, awss3.ServerSideEncryptionAwsKms, aws.StringValue(encryption.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm))
+
+	publicAccess, err := client.GetPublicAccessBlockWithContext(ctx, &awss3.GetPublicAccessBlockInput{Bucket: aws.String(name)})
+	require.NoError(t, err)
+	assert.True(t, aws.BoolValue(publicAccess.PublicAccessBlockConfiguration.BlockPublicPolicy))
+
+	_, err = client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+	require.NoError(t, err)
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, cmd)
+	require.NoError(t, err)
+}
+
+func TestS3BackendLock(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	name := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	cfg := localStackBackendConfig(name)
+
+	s3Backend := s3.NewBackend()
+
+	defer func() {
+		require.NoError(t, s3Backend.DeleteBucket(ctx, l, cfg, opts))
+	}()
+
+	require.NoError(t, s3Backend.Bootstrap(ctx, l, cfg, opts))
+
+	info, err := s3Backend.GetLock(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	assert.Nil(t, info)
+
+	extS3Cfg, err := s3.Config(cfg).ExtendedS3Config(l)
+	require.NoError(t, err)
+
+	client, err := s3.NewClient(l, extS3Cfg, opts)
+	require.NoError(t, err)
+
+	_, err = client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(name),
+		Item: map[string]*dynamodb.AttributeValue{
+			s3.AttrLockID: {S: aws.String(name + "/unit/terraform.tfstate")},
+			"Info":        {S: aws.String(`{"ID":"test-lock","Operation":"OperationTypeApply","Who":"test@host"}`)},
+		},
+	})
+	require.NoError(t, err)
+
+	info, err = s3Backend.GetLock(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "test-lock", info.ID)
+	assert.Equal(t, "test@host", info.Who)
+
+	require.NoError(t, s3Backend.ReleaseLock(ctx, l, cfg, opts))
+
+	info, err = s3Backend.GetLock(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	assert.Nil(t, info)
+}
+
+func TestS3BackendLockReleaseDeclined(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	name := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	cfg := localStackBackendConfig(name)
+
+	s3Backend := s3.NewBackend()
+
+	defer func() {
+		require.NoError(t, s3Backend.DeleteBucket(ctx, l, cfg, opts))
+	}()
+
+	require.NoError(t, s3Backend.Bootstrap(ctx, l, cfg, opts))
+
+	extS3Cfg, err := s3.Config(cfg).ExtendedS3Config(l)
+	require.NoError(t, err)
+
+	client, err := s3.NewClient(l, extS3Cfg, opts)
+	require.NoError(t, err)
+
+	_, err = client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(name),
+		Item: map[string]*dynamodb.AttributeValue{
+			s3.AttrLockID: {S: aws.String(name + "/unit/terraform.tfstate")},
+			"Info":        {S: aws.String(`{"ID":"test-lock","Operation":"OperationTypeApply","Who":"test@host"}`)},
+		},
+	})
+	require.NoError(t, err)
+
+	rootPath := t.TempDir()
+	writeLocalStackUnit(t, rootPath, name)
+
+	stdinPath := filepath.Join(rootPath, "stdin")
+	require.NoError(t, os.WriteFile(stdinPath, []byte("n\n"), 0644))
+
+	realStdin := os.Stdin
+	os.Stdin, err = os.Open(stdinPath)
+	require.NoError(t, err)
+
+	defer func() {
+		_ = os.Stdin.Close()
+		os.Stdin = realStdin
+	}()
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootPath, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = rootPath
+
+	lockOpts := lock.NewOptions(tgOpts)
+	lockOpts.LockID = "test-lock"
+
+	require.NoError(t, lock.Release(ctx, l, lockOpts))
+
+	info, err := s3Backend.GetLock(ctx, l, cfg, opts)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "test-lock", info.ID)
+}
+
+func localStackEndpoint() string {
+	if endpoint := os.Getenv("LOCALSTACK_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+
+	return defaultLocalStackEndpoint
+}
+
+func TestS3BackendStateObjects(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	ctx := context.Background()
+	l := logger.CreateLogger()
+
+	opts, err := options.NewTerragruntOptionsForTest("")
+	require.NoError(t, err)
+
+	opts.NonInteractive = true
+
+	name := "terragrunt-test-" + strings.ToLower(helpers.UniqueID())
+	cfg := localStackBackendConfig(name)
+
+	s3Backend := s3.NewBackend()
+
+	defer func() {
+		require.NoError(t, s3Backend.DeleteBucket(ctx, l, cfg, opts))
+	}()
+
+	require.NoError(t, s3Backend.Bootstrap(ctx, l, cfg, opts))
+
+	extS3Cfg, err := s3.Config(cfg).ExtendedS3Config(l)
+	require.NoError(t, err)
+
+	client, err := s3.NewClient(l, extS3Cfg, opts)
+	require.NoError(t, err)
+
+	for _, key := range []string{"unit/terraform.tfstate", "env:/dev/unit/terraform.tfstate", "old-unit/terraform.tfstate", "unit/notes.txt"} {
+		_, err = client.PutObjectWithContext(ctx, &awss3.PutObjectInput{
+			Bucket: aws.String(name),
+			Key:    aws.String(key),
+			Body:   strings.NewReader("{}"),
+		})
+		require.NoError(t, err)
+	}
+
+	loc, err := s3Backend.GetStateLocation(ctx, l, cfg, opts)
+	require.NoError(t, err)
+
+	objects, err := s3Backend.ListStateObjects(ctx, l, cfg, opts, "")
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	var orphans []string
+
+	for _, obj := range objects {
+		if !loc.Owns(obj) {
+			orphans = append(orphans, obj.Key)
+		}
+	}
+
+	assert.Equal(t, []string{"old-unit/terraform.tfstate"}, orphans)
+
+	require.NoError(t, s3Backend.DeleteStateObject(ctx, l, cfg, opts, "old-unit/terraform.tfstate"))
+
+	objects, err = s3Backend.ListStateObjects(ctx, l, cfg, opts, "old-unit/")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+// writeLocalStackUnit writes a unit under `rootPath` whose `remote_state` uses the LocalStack bucket and table with the given name.
+func writeLocalStackUnit(t *testing.T, rootPath, name string) {
+	t.Helper()
+
+	unitPath := filepath.Join(rootPath, "unit")
+
+	require.NoError(t, os.MkdirAll(unitPath, os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(unitPath, "terragrunt.hcl"), []byte(fmt.Sprintf(`
+remote_state {
+  backend = "s3"
+  config = {
+    bucket                      = "%[1]s"
+    key                         = "unit/terraform.tfstate"
+    region                      = "us-east-1"
+    dynamodb_table              = "%[1]s"
+    force_path_style            = true
+    skip_credentials_validation = true
+    skip_bucket_root_access     = true
+    skip_bucket_enforced_tls    = true
+    endpoints = {
+      s3       = "%[2]s"
+      dynamodb = "%[2]s"
+    }
+  }
+}
+`, name, localStackEndpoint())), 0644))
+}
+
+func localStackBackendConfig(name string) backend.Config {
+	return backend.Config{
+		"bucket":                      name,
+		"key":                         "unit/terraform.tfstate",
+		"region":                      "us-east-1",
+		"dynamodb_table":              name,
+		"force_path_style":            true,
+		"skip_credentials_validation": true,
+		"skip_bucket_root_access":     true,
+		"skip_bucket_enforced_tls":    true,
+		"endpoints": map[string]any{
+			"s3":       localStackEndpoint(),
+			"dynamodb": localStackEndpoint(),
+		},
+	}
+}
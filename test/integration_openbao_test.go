@@ -0,0 +1,54 @@
+//go:build openbao
+
+package test_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFixtureTofuStateEncryptionOpenBao = "fixtures/tofu-state-encryption/openbao"
+
+	// openBaoTransitKeyName is the transit key created on the dev server by `.github/scripts/setup/openbao.sh`.
+	openBaoTransitKeyName = "terragrunt-test"
+)
+
+// TestOpenBaoStateEncryption requires OpenTofu and an OpenBao (or Vault) dev server with the transit secrets engine
+// enabled, whose address and root token are set in `BAO_ADDR` and `BAO_TOKEN`.
+func TestOpenBaoStateEncryption(t *testing.T) {
+	t.Parallel()
+
+	address, token := os.Getenv("BAO_ADDR"), os.Getenv("BAO_TOKEN")
+	if address == "" || token == "" {
+		t.Skip("BAO_ADDR and BAO_TOKEN must be set to run this test.")
+	}
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureTofuStateEncryptionOpenBao)
+	workDir := util.JoinPath(tmpEnvPath, testFixtureTofuStateEncryptionOpenBao)
+	configPath := util.JoinPath(workDir, "terragrunt.hcl")
+
+	helpers.CopyAndFillMapPlaceholders(t, configPath, configPath, map[string]string{
+		"__FILL_IN_BAO_ADDRESS__":  address,
+		"__FILL_IN_BAO_TOKEN__":    token,
+		"__FILL_IN_BAO_KEY_NAME__": openBaoTransitKeyName,
+	})
+
+	helpers.RunTerragrunt(t, "terragrunt apply -auto-approve --non-interactive --working-dir "+workDir)
+
+	stateBytes, err := os.ReadFile(filepath.Join(workDir, "terraform.tfstate"))
+	require.NoError(t, err)
+
+	var state map[string]any
+
+	require.NoError(t, json.Unmarshal(stateBytes, &state))
+	assert.Contains(t, state, "encrypted_data")
+	assert.NotContains(t, state, "resources")
+}
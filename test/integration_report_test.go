@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -20,7 +21,8 @@ import (
 )
 
 const (
-	testFixtureReportPath = "fixtures/report"
+	testFixtureReportPath        = "fixtures/report"
+	testFixtureReportComparePath = "fixtures/report-compare"
 )
 
 func TestTerragruntReportExperiment(t *testing.T) {
@@ -108,7 +110,11 @@ func TestTerragruntReportExperimentSaveToFile(t *testing.T) {
 		},
 	}
 
-	expectedHeader := []string{"Name", "Started", "Ended", "Result", "Reason", "Cause"}
+	expectedHeader := []string{
+		"Name", "Started", "Ended", "Result", "Reason", "Cause", "Selection", "SelectionCause", "ConcurrencyGroup",
+		"ConcurrencyGroupWaitSeconds", "Fingerprint", "PlanAdd", "PlanChange", "PlanDestroy", "PlanImport", "PlanMove",
+		"PlanDestroyed", "Attempts", "AttemptSeconds", "RetryBlocks", "InitSeconds", "Hooks", "Error",
+	}
 
 	expectedRecords := []map[string]string{
 		{"Name": "chain-a", "Result": "failed", "Reason": "run error", "Cause": ""},
@@ -186,8 +192,21 @@ func TestTerragruntReportExperimentSaveToFile(t *testing.T) {
 				content, err := os.ReadFile(reportFilePath)
 				require.NoError(t, err)
 
-				err = json.Unmarshal(content, &records)
+				var jsonRecords []map[string]any
+
+				err = json.Unmarshal(content, &jsonRecords)
 				require.NoError(t, err)
+
+				// Only keep the string fields, as the others are not compared
+				for _, jsonRecord := range jsonRecords {
+					recordMap := make(map[string]string)
+					for key, value := range jsonRecord {
+						if value, ok := value.(string); ok {
+							recordMap[key] = value
+						}
+					}
+					records = append(records, recordMap)
+				}
 			}
 
 			// Verify we have the expected number of records
@@ -210,6 +229,13 @@ func TestTerragruntReportExperimentSaveToFile(t *testing.T) {
 				// Verify Result is one of the expected values
 				assert.True(t, validResults[record["Result"]], "Invalid result value in record %d: %s", i+1, record["Result"])
 
+				// Verify the attempts of the unit retried by its `errors.retry` block
+				if tc.format == "csv" && record["Name"] == "retry-success" {
+					assert.Equal(t, "2", record["Attempts"])
+					assert.Equal(t, "file_not_there_yet", record["RetryBlocks"])
+					assert.Regexp(t, `^error:create_file:[0-9.]+:0$`, record["Hooks"])
+				}
+
 				// Create a new map with only the fields we want to compare
 				compareRecord := map[string]string{
 					"Name":   record["Name"],
@@ -265,6 +291,24 @@ func TestTerragruntReportExperimentSaveToFileWithFormat(t *testing.T) {
 			reportFormat:   "",
 			expectedFormat: "json",
 		},
+		{
+			name:           "junit format from extension",
+			reportFile:     "report.xml",
+			reportFormat:   "",
+			expectedFormat: "junit",
+		},
+		{
+			name:           "markdown format from extension",
+			reportFile:     "report.md",
+			reportFormat:   "",
+			expectedFormat: "markdown",
+		},
+		{
+			name:           "explicit junit format overrides extension",
+			reportFile:     "report.csv",
+			reportFormat:   "junit",
+			expectedFormat: "junit",
+		},
 		{
 			name:           "explicit csv format overrides extension",
 			reportFile:     "report.json",
@@ -336,6 +380,11 @@ func TestTerragruntReportExperimentSaveToFileWithFormat(t *testing.T) {
 				assert.Contains(t, firstRecord, "Started")
 				assert.Contains(t, firstRecord, "Ended")
 				assert.Contains(t, firstRecord, "Result")
+			case "junit":
+				assert.True(t, strings.HasPrefix(string(content), xml.Header+"<testsuites"))
+				assert.Contains(t, string(content), "<testcase ")
+			case "markdown":
+				assert.Contains(t, string(content), "| Unit | Result | Duration | Reason | Cause |")
 			}
 
 			// If schema file is specified, verify it exists and is valid JSON
@@ -430,10 +479,21 @@ func TestTerragruntReportExperimentWithUnitTiming(t *testing.T) {
 		}
 	}
 
-	// Extract the summary section
-	summaryLines := lines[summaryStartIdx : summaryEndIdx+1]
+	// Extract the summary section, without the details of the units, which are checked separately
+	var summaryLines []string
+
+	for _, line := range lines[summaryStartIdx : summaryEndIdx+1] {
+		if !strings.HasPrefix(line, strings.Repeat(" ", 9)) {
+			summaryLines = append(summaryLines, line)
+		}
+	}
+
 	stdoutStr = strings.Join(summaryLines, "\n")
 
+	// The unit retried by its `errors.retry` block shows its attempts and the error hook creating the missing file
+	assert.Contains(t, stdout.String(), `, retried by "file_not_there_yet"`)
+	assert.Regexp(t, `error_hook "create_file" \S+, exit code 0`, stdout.String())
+
 	// Sort lines within each category to make the test deterministic
 	// We're not testing the sorting functionality here, just the per-unit timing display
 	stdoutStr = sortLinesWithinCategories(stdoutStr)
@@ -607,3 +667,61 @@ func sortLinesWithinCategories(input string) string {
 
 	return strings.Join(result, "\n")
 }
+
+func TestTerragruntReportCompare(t *testing.T) {
+	t.Parallel()
+
+	rootPath, err := filepath.Abs(testFixtureReportComparePath)
+	require.NoError(t, err)
+
+	t.Run("human", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt report compare --no-color --working-dir "+rootPath+" old.json new.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, `Added (1)
+   app
+Removed (1)
+   legacy
+Newly failing (1)
+   db: succeeded -> failed
+Duration regressions (1)
+   vpc: 1m0s -> 2m0s (+100%)
+`, stdout)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt report compare --format json --working-dir "+rootPath+" old.json new.json")
+		require.NoError(t, err)
+
+		var comparison map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(stdout), &comparison))
+		assert.Equal(t, []any{"app"}, comparison["Added"])
+		assert.Equal(t, []any{"legacy"}, comparison["Removed"])
+		assert.Len(t, comparison["NewlyFailing"], 1)
+		assert.Empty(t, comparison["NewlyFixed"])
+		assert.Len(t, comparison["DurationRegressions"], 1)
+	})
+
+	t.Run("limits exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt report compare --format markdown --max-new-failures 0 --max-duration-regressions 1 --working-dir "+rootPath+" old.json new.json")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "found 1 newly failing unit(s), more than the limit of 0")
+		assert.NotContains(t, err.Error(), "duration regression")
+		assert.Contains(t, stdout, "| vpc | 60s | 120s | +100% |")
+	})
+
+	t.Run("regressions below the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt report compare --duration-threshold 150 --max-duration-regressions 0 --no-color --working-dir "+rootPath+" old.json new.json")
+		require.NoError(t, err)
+		assert.NotContains(t, stdout, "Duration regressions")
+	})
+}
@@ -22,6 +22,7 @@ const (
 	testFixtureTofuStateEncryptionGCPKMS = "fixtures/tofu-state-encryption/gcp-kms"
 	testFixtureTofuStateEncryptionAWSKMS = "fixtures/tofu-state-encryption/aws-kms"
 	testFixtureRenderJSONWithEncryption  = "fixtures/render-json-with-encryption"
+	testFixtureTofuStateEncryptionRotate = "fixtures/tofu-state-encryption/rotate"
 	gcpKMSKeyID                          = "projects/terragrunt-test/locations/global/keyRings/terragrunt-test/cryptoKeys/terragrunt-test-key"
 	awsKMSKeyID                          = "bd372994-d969-464a-a261-6cc850c58a92"
 	stateFile                            = "terraform.tfstate"
@@ -300,6 +301,47 @@ func TestTofuRenderJSONConfigWithEncryptionExp(t *testing.T) {
 	}
 }
 
+func TestTofuStateEncryptionRotate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		oldKey         = `{ key_provider = "pbkdf2", passphrase = "old-passphrase-123456" }`
+		newKey         = `{ key_provider = "pbkdf2", passphrase = "new-passphrase-123456" }`
+		newKeyFallback = `{ key_provider = "pbkdf2", passphrase = "new-passphrase-123456", fallback = ` + oldKey + ` }`
+	)
+
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureTofuStateEncryptionRotate)
+	rootPath := util.JoinPath(tmpEnvPath, testFixtureTofuStateEncryptionRotate)
+	unitPath := util.JoinPath(rootPath, "unit")
+	configPath := util.JoinPath(unitPath, "terragrunt.hcl")
+	templatePath := util.JoinPath(tmpEnvPath, "terragrunt.hcl.tmpl")
+
+	helpers.CopyAndFillMapPlaceholders(t, configPath, templatePath, nil)
+
+	setEncryption := func(encryption string) {
+		helpers.CopyAndFillMapPlaceholders(t, templatePath, configPath, map[string]string{
+			"__FILL_IN_ENCRYPTION__": encryption,
+		})
+	}
+
+	setEncryption(oldKey)
+	helpers.RunTerragrunt(t, "terragrunt apply -auto-approve --non-interactive --working-dir "+unitPath)
+	validateStateIsEncrypted(t, stateFile, unitPath)
+
+	// Switch to the new key, keeping the old one as the fallback, and rotate.
+	setEncryption(newKeyFallback)
+	helpers.RunTerragrunt(t, "terragrunt backend encryption rotate --non-interactive --working-dir "+rootPath)
+	validateStateIsEncrypted(t, stateFile, unitPath)
+	assert.NoFileExists(t, filepath.Join(rootPath, ".terragrunt-encryption-rotate.json"))
+
+	// The state can be decrypted with the new key only.
+	setEncryption(newKey)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt output -raw message --non-interactive --working-dir "+unitPath)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", stdout)
+}
+
 // Check the statefile contains an encrypted_data key
 // and that the encrypted_data is base64 encoded
 func validateStateIsEncrypted(t *testing.T, fileName string, path string) {
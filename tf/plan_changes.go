@@ -0,0 +1,150 @@
+package tf
+
+import (
+	"bufio"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/report"
+)
+
+// maxPlanLineSize is the maximum size of a line of the output of a plan, which can be long with `-json`.
+const maxPlanLineSize = 1024 * 1024
+
+var (
+	// planSummaryRegex matches the summary of a plan in human-readable output, e.g.
+	// `Plan: 1 to import, 2 to add, 0 to change, 1 to destroy.`
+	planSummaryRegex = regexp.MustCompile(`^Plan: (.+)\.$`)
+	// planSummaryPartRegex matches a count of the summary of a plan, e.g. `2 to add`.
+	planSummaryPartRegex = regexp.MustCompile(`(\d+) to (import|add|change|destroy)`)
+	// planNoChangesRegex matches the message of a plan without changes in human-readable output.
+	planNoChangesRegex = regexp.MustCompile(`^No changes\.`)
+	// planDestroyedRegex matches the header of a resource the plan destroys or replaces in human-readable output, e.g.
+	// `# aws_instance.web will be destroyed`.
+	planDestroyedRegex = regexp.MustCompile(`^# (.+?)(?: \(deposed object \S+\))? (?:will be destroyed|(?:is tainted, so )?must be replaced)$`)
+	// planMovedRegex matches the header of a resource the plan moves in human-readable output, e.g.
+	// `# aws_instance.web has moved to aws_instance.app`.
+	planMovedRegex = regexp.MustCompile(`^# (.+?) has moved to (.+)$`)
+	// ansiRegex matches the ANSI escape codes coloring human-readable output.
+	ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+)
+
+// planJSONMessage is a message of the machine-readable output of `plan -json`, with only the fields needed to count
+// the changes.
+type planJSONMessage struct {
+	Change *struct {
+		PreviousResource *struct {
+			Addr string `json:"addr"`
+		} `json:"previous_resource"`
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+		Action string `json:"action"`
+	} `json:"change"`
+	Changes *struct {
+		Operation string `json:"operation"`
+		Add       int    `json:"add"`
+		Change    int    `json:"change"`
+		Remove    int    `json:"remove"`
+		Import    int    `json:"import"`
+	} `json:"changes"`
+	Type string `json:"type"`
+}
+
+// ParsePlanChanges returns the resource changes of a plan from its standard output, either human-readable or
+// machine-readable with `-json`. It returns nil if the output has no summary of the changes, e.g. if the plan failed.
+func ParsePlanChanges(stdout string) *report.PlanChanges {
+	if strings.HasPrefix(strings.TrimSpace(stdout), "{") {
+		return parseJSONPlanChanges(stdout)
+	}
+
+	return parseHumanPlanChanges(stdout)
+}
+
+func parseHumanPlanChanges(stdout string) *report.PlanChanges {
+	var (
+		changes = &report.PlanChanges{}
+		found   bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(stdout))
+	scanner.Buffer(nil, maxPlanLineSize)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(ansiRegex.ReplaceAllString(scanner.Text(), ""))
+
+		switch {
+		case planNoChangesRegex.MatchString(line):
+			found = true
+		case planDestroyedRegex.MatchString(line):
+			changes.Destroyed = append(changes.Destroyed, planDestroyedRegex.FindStringSubmatch(line)[1])
+		case planMovedRegex.MatchString(line):
+			changes.Move++
+		case planSummaryRegex.MatchString(line):
+			found = true
+
+			for _, part := range planSummaryPartRegex.FindAllStringSubmatch(line, -1) {
+				count, _ := strconv.Atoi(part[1])
+
+				switch part[2] {
+				case "import":
+					changes.Import = count
+				case "add":
+					changes.Add = count
+				case "change":
+					changes.Change = count
+				case "destroy":
+					changes.Destroy = count
+				}
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return changes
+}
+
+func parseJSONPlanChanges(stdout string) *report.PlanChanges {
+	var (
+		changes = &report.PlanChanges{}
+		found   bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(stdout))
+	scanner.Buffer(nil, maxPlanLineSize)
+
+	for scanner.Scan() {
+		var msg planJSONMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			continue
+		}
+
+		switch {
+		case msg.Type == "planned_change" && msg.Change != nil:
+			if msg.Change.Action == "delete" || msg.Change.Action == "replace" {
+				changes.Destroyed = append(changes.Destroyed, msg.Change.Resource.Addr)
+			}
+
+			if msg.Change.PreviousResource != nil && msg.Change.PreviousResource.Addr != msg.Change.Resource.Addr {
+				changes.Move++
+			}
+		case msg.Type == "change_summary" && msg.Changes != nil && msg.Changes.Operation == "plan":
+			found = true
+			changes.Add = msg.Changes.Add
+			changes.Change = msg.Changes.Change
+			changes.Destroy = msg.Changes.Remove
+			changes.Import = msg.Changes.Import
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return changes
+}
@@ -0,0 +1,98 @@
+package tf_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePlanChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected *report.PlanChanges
+		name     string
+		stdout   string
+	}{
+		{
+			name: "human-readable",
+			stdout: `
+Terraform will perform the following actions:
+
+  # aws_instance.web will be destroyed
+  - resource "aws_instance" "web" {
+    }
+
+  # aws_subnet.private["a"] must be replaced
+-/+ resource "aws_subnet" "private" {
+    }
+
+  # aws_instance.db is tainted, so must be replaced
+-/+ resource "aws_instance" "db" {
+    }
+
+  # aws_s3_bucket.logs has moved to aws_s3_bucket.audit
+    resource "aws_s3_bucket" "audit" {
+    }
+
+  # aws_iam_role.app will be imported
+    resource "aws_iam_role" "app" {
+    }
+
+Plan: 1 to import, 3 to add, 1 to change, 3 to destroy.
+`,
+			expected: &report.PlanChanges{
+				Import:    1,
+				Add:       3,
+				Change:    1,
+				Destroy:   3,
+				Move:      1,
+				Destroyed: []string{"aws_instance.web", `aws_subnet.private["a"]`, "aws_instance.db"},
+			},
+		},
+		{
+			name:     "colored",
+			stdout:   "  \x1b[1m# aws_instance.web\x1b[0m will be destroyed\n\x1b[1mPlan:\x1b[0m 0 to add, 0 to change, 1 to destroy.\n",
+			expected: &report.PlanChanges{Destroy: 1, Destroyed: []string{"aws_instance.web"}},
+		},
+		{
+			name:     "no changes",
+			stdout:   "\nNo changes. Your infrastructure matches the configuration.\n",
+			expected: &report.PlanChanges{},
+		},
+		{
+			name: "json",
+			stdout: `{"@level":"info","@message":"Terraform 1.9.0","type":"version"}
+{"@level":"info","change":{"resource":{"addr":"aws_instance.web"},"action":"delete"},"type":"planned_change"}
+{"@level":"info","change":{"resource":{"addr":"aws_instance.db"},"action":"replace"},"type":"planned_change"}
+{"@level":"info","change":{"resource":{"addr":"aws_s3_bucket.audit"},"previous_resource":{"addr":"aws_s3_bucket.logs"},"action":"move"},"type":"planned_change"}
+{"@level":"info","change":{"resource":{"addr":"aws_vpc.main"},"action":"create"},"type":"planned_change"}
+{"@level":"info","changes":{"add":2,"change":0,"import":0,"remove":2,"operation":"plan"},"type":"change_summary"}
+`,
+			expected: &report.PlanChanges{
+				Add:       2,
+				Destroy:   2,
+				Move:      1,
+				Destroyed: []string{"aws_instance.web", "aws_instance.db"},
+			},
+		},
+		{
+			name:   "failed",
+			stdout: "Error: Invalid reference\n",
+		},
+		{
+			name:   "json apply",
+			stdout: `{"@level":"info","changes":{"add":1,"change":0,"import":0,"remove":0,"operation":"apply"},"type":"change_summary"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tf.ParsePlanChanges(tt.stdout))
+		})
+	}
+}
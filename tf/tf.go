@@ -51,6 +51,7 @@ const (
 	FlagNameVersion          = "-version"
 	FlagNameJSON             = "-json"
 	FlagNameNoColor          = "-no-color"
+	FlagNameForce            = "-force"
 	// `apply -destroy` is alias for `destroy`
 	FlagNameDestroy = "-destroy"
 